@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -8,14 +9,15 @@ import (
 	"syscall"
 
 	"github.com/spf13/cobra"
-	"github.com/spiceai/spiceai/pkg/context"
+	spice_context "github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/loggers"
 	"github.com/spiceai/spiceai/pkg/runtime"
 	"github.com/spiceai/spiceai/pkg/version"
 )
 
 var (
-	contextFlag string
+	contextFlag   string
+	logFormatFlag string
 )
 
 func main() {
@@ -37,8 +39,15 @@ var RootCmd = &cobra.Command{
 	Use:   "spiced",
 	Short: "Spice Runtime",
 	Args:  cobra.MaximumNArgs(1),
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		err := loggers.Configure(logFormatFlag)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		rtcontext, err := context.NewContext(contextFlag)
+		rtcontext, err := spice_context.NewContext(contextFlag)
 		if err != nil {
 			fmt.Println(err)
 			os.Exit(1)
@@ -50,7 +59,7 @@ var RootCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		context.SetContext(rtcontext)
+		spice_context.SetContext(rtcontext)
 
 		var manifestPath string
 		if len(args) > 0 {
@@ -59,21 +68,24 @@ var RootCmd = &cobra.Command{
 
 		isSingleRun := manifestPath != ""
 
+		// Cancelled on Ctrl-C or SIGTERM, which stops the runtime and lets Run() return
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+		defer stop()
+		go func() {
+			// Restore default signal handling so a second Ctrl-C kills a shutdown that is taking too long
+			<-ctx.Done()
+			stop()
+		}()
+
 		if isSingleRun {
-			err = runtime.SingleRun(manifestPath)
+			err = runtime.SingleRun(ctx, manifestPath)
 		} else {
-			err = runtime.Run()
+			err = runtime.Run(ctx)
 		}
+		runtime.Shutdown()
 		if err != nil {
 			log.Fatalln(err)
 		}
-		defer runtime.Shutdown()
-
-		if !isSingleRun {
-			stop := make(chan os.Signal, 1)
-			signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
-			<-stop
-		}
 	},
 }
 
@@ -87,5 +99,6 @@ var VersionCmd = &cobra.Command{
 
 func init() {
 	RootCmd.Flags().StringVar(&contextFlag, "context", "metal", "Runs Spice.ai in the given context, either 'docker' or 'metal'")
+	RootCmd.PersistentFlags().StringVar(&logFormatFlag, "log-format", loggers.LogFormatText, "Log output format, either 'text' or 'json'")
 	RootCmd.AddCommand(VersionCmd)
 }
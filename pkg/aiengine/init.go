@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/spiceai/spiceai/pkg/pods"
 	"github.com/spiceai/spiceai/pkg/proto/aiengine_pb"
 )
 
-var podInitMap map[string]*aiengine_pb.InitRequest
+var (
+	podInitMap      map[string]*aiengine_pb.InitRequest
+	podInitMapMutex sync.RWMutex
+)
 
 func InitializePod(pod *pods.Pod) error {
 	err := pod.ValidateForTraining()
@@ -33,7 +37,9 @@ func InitializePod(pod *pods.Pod) error {
 		return err
 	}
 
+	podInitMapMutex.Lock()
 	podInitMap[pod.Name] = podInit
+	podInitMapMutex.Unlock()
 
 	return nil
 }
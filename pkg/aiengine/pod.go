@@ -76,7 +76,9 @@ func ExportPod(podName string, tag string, request *runtime_pb.ExportModel) erro
 		}
 	}
 
+	podInitMapMutex.RLock()
 	init := podInitMap[podName]
+	podInitMapMutex.RUnlock()
 	initBytes, err := proto.Marshal(init)
 	if err != nil {
 		return err
@@ -3,29 +3,50 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/registry"
 )
 
+var addVersion string
+
 var addCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Add Pod - adds a pod to the project",
 	Args:  cobra.MinimumNArgs(1),
 	Example: `
 spice add samples/LogPruner
+spice add samples/LogPruner@0.1.0
+spice add samples/LogPruner --version 0.1.0
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		podPath := args[0]
 
+		if addVersion != "" {
+			if _, isLocal := registry.GetRegistry(podPath).(*registry.LocalFileRegistry); isLocal {
+				fmt.Println("--version can only be used with pods from spicerack.org, not local manifests.")
+				return
+			}
+			if strings.Contains(podPath, "@") {
+				fmt.Println("Specify the pod version either with --version or as <pod>@<version>, not both.")
+				return
+			}
+			podPath = fmt.Sprintf("%s@%s", podPath, addVersion)
+		}
+
 		fmt.Printf("Getting Pod %s ...\n", podPath)
 
 		r := registry.GetRegistry(podPath)
 		downloadPath, err := r.GetPod(podPath)
 		if err != nil {
 			var itemNotFound *registry.RegistryItemNotFound
-			if errors.As(err, &itemNotFound) {
+			var versionNotFound *registry.RegistryItemVersionNotFound
+			if errors.As(err, &versionNotFound) {
+				podName := strings.Split(podPath, "@")[0]
+				fmt.Printf("The pod '%s' exists, but not at the requested version. Run 'spice add %s' to add the latest version.\n", podPath, podName)
+			} else if errors.As(err, &itemNotFound) {
 				fmt.Printf("No pod found with the name '%s'.\n", podPath)
 			} else {
 				fmt.Println(err)
@@ -40,6 +61,7 @@ spice add samples/LogPruner
 }
 
 func init() {
+	addCmd.Flags().StringVar(&addVersion, "version", "", "The version of the pod to add, defaults to the latest")
 	addCmd.Flags().BoolP("help", "h", false, "Print this help message")
 	RootCmd.AddCommand(addCmd)
 }
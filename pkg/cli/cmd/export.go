@@ -47,6 +47,8 @@ spice export trader -o ./models
 			fmt.Println(err.Error())
 			return
 		}
+
+		fmt.Println(aurora.Green(fmt.Sprintf("Exported pod %s to %s", podName, filepath.Join(directory, filename))))
 	},
 }
 
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
@@ -11,6 +13,10 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const (
+	importVerifyTimeout = 10 * time.Second
+)
+
 var importTag string
 
 var ImportCmd = &cobra.Command{
@@ -49,31 +55,81 @@ spice import --tag latest ./models/trader.spicepod
 			return nil
 		})
 		if err != nil {
-			fmt.Println(err.Error())
+			fmt.Printf("%s: invalid spicepod %s: %s\n", aurora.Red("error"), archivePath, err.Error())
 			return
 		}
 
-		if init == nil {
+		if init == nil || init.Pod == "" {
 			fmt.Println("Invalid spicepod: " + archivePath)
 			return
 		}
 
+		err = validateSpicepodArchive(archivePath, init.Pod)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+
 		runtimeClient, err := runtime.NewRuntimeClient(init.Pod)
 		if err != nil {
 			fmt.Println(err.Error())
 			return
 		}
 
+		fmt.Printf("Importing trained model for pod %s with tag %s ...\n", aurora.Blue(init.Pod), aurora.Blue(importTag))
+
 		err = runtimeClient.ImportModel(relativePath, importTag)
 		if err != nil {
 			fmt.Println(err.Error())
 			return
 		}
 
+		warning, err := runtimeClient.VerifyModel(importTag, importVerifyTimeout)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		if warning != "" {
+			fmt.Printf("%s: %s\n", aurora.Yellow("warning"), warning)
+		}
+
 		fmt.Println(aurora.Green("Imported trained model!"))
 	},
 }
 
+// An exported spicepod contains the pod manifest and its model directory alongside init.pb,
+// check they are there before asking the runtime to import it
+func validateSpicepodArchive(archivePath string, podName string) error {
+	fileNames, err := util.GetZipArchiveFileNames(archivePath)
+	if err != nil {
+		return fmt.Errorf("%s: invalid spicepod %s: %w", aurora.Red("error"), archivePath, err)
+	}
+
+	manifestName := fmt.Sprintf("%s.yaml", podName)
+	modelPrefix := fmt.Sprintf("%s.model/", podName)
+
+	hasManifest := false
+	hasModel := false
+	for _, name := range fileNames {
+		if name == manifestName {
+			hasManifest = true
+		}
+		if strings.HasPrefix(name, modelPrefix) {
+			hasModel = true
+		}
+	}
+
+	if !hasManifest {
+		return fmt.Errorf("%s: invalid spicepod %s: missing the manifest for pod %s", aurora.Red("error"), archivePath, podName)
+	}
+
+	if !hasModel {
+		return fmt.Errorf("%s: invalid spicepod %s: missing the trained model for pod %s", aurora.Red("error"), archivePath, podName)
+	}
+
+	return nil
+}
+
 func init() {
 	ImportCmd.Flags().StringVar(&importTag, "tag", "latest", "Specify which tag to import the model to")
 	RootCmd.AddCommand(ImportCmd)
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSpicepodArchive(t *testing.T) {
+	t.Run("validateSpicepodArchive() -- Accepts an archive with manifest and model", testValidateSpicepodArchive([]string{"init.pb", "trader.yaml", "trader.model/saved_model.pb"}, ""))
+	t.Run("validateSpicepodArchive() -- Rejects an archive missing the manifest", testValidateSpicepodArchive([]string{"init.pb", "trader.model/saved_model.pb"}, "missing the manifest for pod trader"))
+	t.Run("validateSpicepodArchive() -- Rejects an archive missing the model", testValidateSpicepodArchive([]string{"init.pb", "trader.yaml"}, "missing the trained model for pod trader"))
+}
+
+func testValidateSpicepodArchive(fileNames []string, expectedError string) func(*testing.T) {
+	return func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "trader.spicepod")
+		createTestArchive(t, archivePath, fileNames)
+
+		err := validateSpicepodArchive(archivePath, "trader")
+		if expectedError == "" {
+			assert.NoError(t, err)
+			return
+		}
+
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), expectedError)
+		}
+	}
+}
+
+func createTestArchive(t *testing.T, archivePath string, fileNames []string) {
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer archive.Close()
+
+	zipWriter := zip.NewWriter(archive)
+	for _, name := range fileNames {
+		w, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.Write([]byte(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = zipWriter.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,16 +8,19 @@ import (
 	"github.com/spiceai/spiceai/pkg/cli/runtime"
 )
 
+var runNoInstall bool
+
 var runCmd = &cobra.Command{
 	Use:   "run",
 	Short: "Run Spice.ai - starts the Spice.ai runtime, installing if necessary",
 	Example: `
 spice run
+spice run --no-install
 
 # See more at: https://docs.spiceai.org/
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		err := runtime.Run(contextFlag, "")
+		err := runtime.Run(contextFlag, "", runNoInstall)
 		if err != nil {
 			fmt.Println(err.Error())
 			os.Exit(1)
@@ -27,6 +30,7 @@ spice run
 
 func init() {
 	runCmd.Flags().StringVar(&contextFlag, "context", "docker", "Runs Spice.ai in the given context, either 'docker' or 'metal'")
+	runCmd.Flags().BoolVar(&runNoInstall, "no-install", false, "Fail if the Spice.ai runtime isn't installed instead of downloading it, and skip upgrades")
 	runCmd.Flags().BoolP("help", "h", false, "Print this help message")
 	RootCmd.AddCommand(runCmd)
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/spiceai/spiceai/pkg/context"
+	"github.com/spiceai/spiceai/pkg/github"
+	"github.com/spiceai/spiceai/pkg/registry"
 )
 
 var (
@@ -40,4 +42,17 @@ func initConfig() {
 	viper.SetEnvPrefix("spice")
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	viper.AutomaticEnv()
+
+	// --offline or SPICE_OFFLINE=true
+	github.SetOffline(viper.GetBool("offline"))
+	registry.SetOffline(viper.GetBool("offline"))
+}
+
+func init() {
+	RootCmd.PersistentFlags().Bool("offline", false, "Skip all network calls, using only what is already installed")
+	err := viper.BindPFlag("offline", RootCmd.PersistentFlags().Lookup("offline"))
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
 }
@@ -13,7 +13,6 @@ import (
 	"github.com/spiceai/spiceai/pkg/config"
 	"github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/pods"
-	"github.com/spiceai/spiceai/pkg/util"
 )
 
 var trainCmd = &cobra.Command{
@@ -33,7 +32,7 @@ spice train logpruner.yaml
 		if err != nil {
 			podPath = pods.FindFirstManifestPath()
 		} else {
-			err := runtime.Run(contextFlag, podPath)
+			err := runtime.Run(contextFlag, podPath, false)
 			if err != nil {
 				fmt.Println(err.Error())
 				os.Exit(1)
@@ -67,9 +66,9 @@ spice train logpruner.yaml
 
 		serverBaseUrl := runtimeConfig.ServerBaseUrl()
 
-		err = util.IsRuntimeServerHealthy(serverBaseUrl, http.DefaultClient)
+		err = runtime.Ping(serverBaseUrl)
 		if err != nil {
-			fmt.Printf("failed to reach %s. is the spice runtime running?\n", serverBaseUrl)
+			fmt.Println(err.Error())
 			return
 		}
 
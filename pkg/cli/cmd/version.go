@@ -1,53 +1,107 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spiceai/spiceai/pkg/context"
+	"github.com/spiceai/spiceai/pkg/github"
 	"github.com/spiceai/spiceai/pkg/version"
 )
 
+var versionJson bool
+
+type versionInfo struct {
+	CliVersion       string `json:"cli_version"`
+	RuntimeVersion   string `json:"runtime_version,omitempty"`
+	RuntimeInstalled bool   `json:"runtime_installed"`
+	LatestCli        string `json:"latest_cli,omitempty"`
+	Context          string `json:"context"`
+}
+
+type versionError struct {
+	Error string `json:"error"`
+}
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Spice CLI version",
 	Example: `
 spice version
+spice version --json
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Printf("CLI version:     %s\n", version.Version())
+		info := &versionInfo{
+			CliVersion: version.Version(),
+			Context:    contextFlag,
+		}
 
-		var rtversion string
-		var err error
+		if !versionJson {
+			fmt.Printf("CLI version:     %s\n", info.CliVersion)
+		}
 
 		rtcontext, err := context.NewContext(contextFlag)
 		if err != nil {
-			fmt.Println(err.Error())
-			os.Exit(1)
+			exitWithVersionError(err)
 		}
 
 		err = rtcontext.Init()
 		if err != nil {
-			fmt.Println(err.Error())
-			os.Exit(1)
+			exitWithVersionError(err)
 		}
 
-		if rtcontext.IsRuntimeInstallRequired() {
-			rtversion = "not installed"
-		} else {
-			rtversion, err = rtcontext.Version()
+		info.RuntimeInstalled = !rtcontext.IsRuntimeInstallRequired()
+		if info.RuntimeInstalled {
+			info.RuntimeVersion, err = rtcontext.Version()
+			if err != nil {
+				exitWithVersionError(fmt.Errorf("error getting runtime version: %w", err))
+			}
+		}
+
+		if versionJson {
+			// Left out when offline or GitHub can't be reached, the rest of the output is still useful
+			if !github.IsOffline() {
+				release, err := github.GetLatestCliRelease()
+				if err == nil {
+					info.LatestCli = github.GetCliVersion(release)
+				}
+			}
+
+			output, err := json.MarshalIndent(info, "", "  ")
 			if err != nil {
-				fmt.Printf("error getting runtime version: %s\n", err)
-				os.Exit(1)
+				exitWithVersionError(err)
 			}
+			fmt.Println(string(output))
+			return
+		}
+
+		rtversion := info.RuntimeVersion
+		if !info.RuntimeInstalled {
+			rtversion = "not installed"
 		}
 
 		fmt.Printf("Runtime version: %s\n", rtversion)
 	},
 }
 
+// With --json, errors are printed as JSON too so consumers can always parse the output
+func exitWithVersionError(err error) {
+	if versionJson {
+		output, marshalErr := json.Marshal(&versionError{Error: err.Error()})
+		if marshalErr == nil {
+			fmt.Println(string(output))
+			os.Exit(1)
+		}
+	}
+
+	fmt.Println(err.Error())
+	os.Exit(1)
+}
+
 func init() {
 	versionCmd.Flags().StringVar(&contextFlag, "context", "docker", "Runs Spice.ai in the given context, either 'docker' or 'metal'")
+	versionCmd.Flags().BoolVar(&versionJson, "json", false, "Print version information as JSON")
 	RootCmd.AddCommand(versionCmd)
 }
@@ -3,18 +3,31 @@ package runtime
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/spiceai/spiceai/pkg/config"
 	"github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/pods"
+	"github.com/spiceai/spiceai/pkg/proto/aiengine_pb"
 	"github.com/spiceai/spiceai/pkg/proto/runtime_pb"
 	"github.com/spiceai/spiceai/pkg/util"
 )
 
+const (
+	runtimePingTimeout = 2 * time.Second
+	modelPollInterval  = 500 * time.Millisecond
+
+	// Results the AI engine returns for a loaded model that can't produce a recommendation yet
+	inferenceNotEnoughData   = "not_enough_data"
+	inferenceTagNotSupported = "tag_not_yet_supported"
+)
+
 type RuntimeClient struct {
 	runtimeConfig *config.SpiceConfiguration
 	pod           *pods.Pod
@@ -43,10 +56,26 @@ func NewRuntimeClient(podName string) (*RuntimeClient, error) {
 	}, nil
 }
 
+// Ping checks the runtime is up before calling it, failing fast with a
+// readable error rather than a chain of wrapped network errors
+func Ping(serverBaseUrl string) error {
+	client := &http.Client{Timeout: runtimePingTimeout}
+	err := util.IsRuntimeServerHealthy(serverBaseUrl, client)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("failed to reach %s. is the spice runtime running? start it with 'spice run'", serverBaseUrl)
+		}
+		return fmt.Errorf("the spice runtime at %s is not ready: %w", serverBaseUrl, err)
+	}
+
+	return nil
+}
+
 func (r *RuntimeClient) ExportModel(directory string, filename string, tag string) error {
-	err := util.IsRuntimeServerHealthy(r.serverBaseUrl, http.DefaultClient)
+	err := Ping(r.serverBaseUrl)
 	if err != nil {
-		return fmt.Errorf("failed to reach %s. is the spice runtime running? %w", r.serverBaseUrl, err)
+		return err
 	}
 
 	exportRequest := &runtime_pb.ExportModel{
@@ -62,8 +91,9 @@ func (r *RuntimeClient) ExportModel(directory string, filename string, tag strin
 	exportModelUrl := fmt.Sprintf("%s/api/v0.1/pods/%s/models/%s/export", r.serverBaseUrl, r.pod.Name, tag)
 	response, err := http.DefaultClient.Post(exportModelUrl, "application/json", bytes.NewReader(exportRequestBytes))
 	if err != nil {
-		return nil
+		return fmt.Errorf("failed to export model: %w", err)
 	}
+	defer response.Body.Close()
 
 	if response.StatusCode != 200 {
 		body, err := io.ReadAll(response.Body)
@@ -77,9 +107,9 @@ func (r *RuntimeClient) ExportModel(directory string, filename string, tag strin
 }
 
 func (r *RuntimeClient) ImportModel(archivePath string, tag string) error {
-	err := util.IsRuntimeServerHealthy(r.serverBaseUrl, http.DefaultClient)
+	err := Ping(r.serverBaseUrl)
 	if err != nil {
-		return fmt.Errorf("failed to reach %s. is the spice runtime running? %w", r.serverBaseUrl, err)
+		return err
 	}
 
 	importRequest := &runtime_pb.ImportModel{
@@ -96,8 +126,9 @@ func (r *RuntimeClient) ImportModel(archivePath string, tag string) error {
 	importModelUrl := fmt.Sprintf("%s/api/v0.1/pods/%s/models/%s/import", r.serverBaseUrl, r.pod.Name, tag)
 	response, err := http.DefaultClient.Post(importModelUrl, "application/json", bytes.NewReader(importRequestBytes))
 	if err != nil {
-		return nil
+		return fmt.Errorf("failed to import model: %w", err)
 	}
+	defer response.Body.Close()
 
 	if response.StatusCode != 200 {
 		body, err := io.ReadAll(response.Body)
@@ -110,10 +141,54 @@ func (r *RuntimeClient) ImportModel(archivePath string, tag string) error {
 	return nil
 }
 
+// VerifyModel polls the runtime until it can serve recommendations from the model stored under tag.
+// The AI engine can't always produce a recommendation straight after an import, so in that case
+// the model is still considered loaded and the reason is returned as a warning.
+func (r *RuntimeClient) VerifyModel(tag string, timeout time.Duration) (string, error) {
+	recommendationUrl := fmt.Sprintf("%s/api/v0.1/pods/%s/models/%s/recommendation", r.serverBaseUrl, r.pod.Name, tag)
+	client := &http.Client{Timeout: runtimePingTimeout}
+	deadline := time.Now().Add(timeout)
+
+	lastError := "no response from the runtime"
+	for {
+		response, err := client.Get(recommendationUrl)
+		if err != nil {
+			lastError = err.Error()
+		} else {
+			body, err := io.ReadAll(response.Body)
+			response.Body.Close()
+			if err != nil {
+				lastError = err.Error()
+			} else if response.StatusCode == 200 {
+				return "", nil
+			} else {
+				var inference aiengine_pb.InferenceResult
+				if err := json.Unmarshal(body, &inference); err == nil && inference.Response != nil {
+					switch inference.Response.Result {
+					case inferenceNotEnoughData:
+						return "the model is loaded, but recommendations need more observations first", nil
+					case inferenceTagNotSupported:
+						return fmt.Sprintf("the model is loaded, but the AI engine only serves recommendations for the 'latest' tag, not '%s'", tag), nil
+					}
+					lastError = inference.Response.Result
+				} else {
+					lastError = string(body)
+				}
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("the imported model for pod %s with tag %s could not be served after %s: %s", r.pod.Name, tag, timeout, lastError)
+		}
+
+		time.Sleep(modelPollInterval)
+	}
+}
+
 func (r *RuntimeClient) StartTraining() error {
-	err := util.IsRuntimeServerHealthy(r.serverBaseUrl, http.DefaultClient)
+	err := Ping(r.serverBaseUrl)
 	if err != nil {
-		return fmt.Errorf("failed to reach %s. is the spice runtime running? %w", r.serverBaseUrl, err)
+		return err
 	}
 
 	trainUrl := fmt.Sprintf("%s/api/v0.1/pods/%s/train", r.serverBaseUrl, r.pod.Name)
@@ -0,0 +1,82 @@
+package runtime
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spiceai/spiceai/pkg/pods"
+	"github.com/spiceai/spiceai/pkg/proto/aiengine_pb"
+	"github.com/spiceai/spiceai/pkg/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyModel(t *testing.T) {
+	t.Run("VerifyModel() -- Succeeds when a recommendation is served", testVerifyModel(okResponse, false, false))
+	t.Run("VerifyModel() -- Warns when there is not enough data", testVerifyModel(resultResponse(inferenceNotEnoughData), true, false))
+	t.Run("VerifyModel() -- Warns when the tag isn't supported", testVerifyModel(resultResponse(inferenceTagNotSupported), true, false))
+	t.Run("VerifyModel() -- Fails when the pod never initializes", testVerifyModel(resultResponse("pod_not_initialized"), false, true))
+	t.Run("VerifyModel() -- Polls until the model is served", testVerifyModelPolls())
+}
+
+func okResponse(w http.ResponseWriter) {
+	_, _ = w.Write([]byte("{}"))
+}
+
+func resultResponse(result string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		body, _ := json.Marshal(&aiengine_pb.InferenceResult{
+			Response: &aiengine_pb.Response{Result: result, Error: true},
+		})
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(body)
+	}
+}
+
+func newTestRuntimeClient(serverBaseUrl string) *RuntimeClient {
+	return &RuntimeClient{
+		pod:           &pods.Pod{PodSpec: spec.PodSpec{Name: "trader"}},
+		serverBaseUrl: serverBaseUrl,
+	}
+}
+
+func testVerifyModel(respond func(w http.ResponseWriter), expectWarning bool, expectError bool) func(*testing.T) {
+	return func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v0.1/pods/trader/models/latest/recommendation", r.URL.Path)
+			respond(w)
+		}))
+		defer server.Close()
+
+		warning, err := newTestRuntimeClient(server.URL).VerifyModel("latest", 100*time.Millisecond)
+		if expectError {
+			assert.Error(t, err)
+			return
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectWarning, warning != "")
+	}
+}
+
+func testVerifyModelPolls() func(*testing.T) {
+	return func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) < 2 {
+				resultResponse("pod_not_initialized")(w)
+				return
+			}
+			okResponse(w)
+		}))
+		defer server.Close()
+
+		warning, err := newTestRuntimeClient(server.URL).VerifyModel("latest", 5*time.Second)
+		assert.NoError(t, err)
+		assert.Empty(t, warning)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	}
+}
@@ -1,15 +1,17 @@
 package runtime
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
 
 	"github.com/spiceai/spiceai/pkg/context"
+	"github.com/spiceai/spiceai/pkg/github"
 	"github.com/spiceai/spiceai/pkg/util"
 )
 
-func Run(contextFlag string, manifestPath string) error {
+func Run(contextFlag string, manifestPath string, noInstall bool) error {
 	fmt.Println("Spice.ai runtime starting...")
 
 	rtcontext, err := context.NewContext(contextFlag)
@@ -24,12 +26,20 @@ func Run(contextFlag string, manifestPath string) error {
 		os.Exit(1)
 	}
 
+	offline := github.IsOffline()
+
 	shouldInstall := false
 	var upgradeVersion string
 	if installRequired := rtcontext.IsRuntimeInstallRequired(); installRequired {
 		fmt.Println("The Spice.ai runtime has not yet been installed.")
+		if offline {
+			return errors.New("the Spice.ai runtime cannot be installed while offline")
+		}
+		if noInstall {
+			return errors.New("the Spice.ai runtime cannot be installed with --no-install, run 'spice run' without it to install")
+		}
 		shouldInstall = true
-	} else {
+	} else if !offline && !noInstall {
 		upgradeVersion, err = rtcontext.IsRuntimeUpgradeAvailable()
 		if err != nil {
 			log.Printf("error checking for runtime upgrade: %s", err.Error())
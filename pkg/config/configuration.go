@@ -13,6 +13,10 @@ import (
 
 type SpiceConfiguration struct {
 	HttpPort uint `json:"http_port,omitempty" mapstructure:"http_port,omitempty" yaml:"http_port,omitempty"`
+	// Number of pod manifests loaded in parallel at startup, defaults to 4 when unset
+	PodScanConcurrency uint `json:"pod_scan_concurrency,omitempty" mapstructure:"pod_scan_concurrency,omitempty" yaml:"pod_scan_concurrency,omitempty"`
+	// Milliseconds to wait for pod manifest changes to settle before reloading, defaults to 500 when unset
+	PodsWatcherDebounceMs uint `json:"pods_watcher_debounce_ms,omitempty" mapstructure:"pods_watcher_debounce_ms,omitempty" yaml:"pods_watcher_debounce_ms,omitempty"`
 }
 
 func LoadDefaultConfiguration() *SpiceConfiguration {
@@ -5,6 +5,7 @@ const (
 	SpiceConfigBaseName    = "spice.config"
 	SpicePodsDirectoryName = "spicepods"
 	SpiceRuntimeFilename   = "spiced"
+	SpiceCliFilename       = "spice"
 	SpicePodFileExtension  = ".spicepod"
 	PythonCmd              = "python3"
 	SpiceEnvVarPrefix      = "SPICE_"
@@ -8,11 +8,18 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spiceai/spiceai/pkg/constants"
 	"github.com/spiceai/spiceai/pkg/github"
 	"github.com/spiceai/spiceai/pkg/util"
 	spice_version "github.com/spiceai/spiceai/pkg/version"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	runtimeVersionCacheFilename = "runtime_version.txt"
+	runtimeVersionCacheTTL      = 24 * time.Hour
 )
 
 type MetalContext struct {
@@ -111,7 +118,12 @@ func (c *MetalContext) InstallOrUpgradeRuntime() error {
 
 	err = github.DownloadRuntimeAsset(release, c.spiceBinDir)
 	if err != nil {
-		fmt.Println("Error downloading Spice.ai runtime binaries.")
+		var checksumErr *github.ChecksumMismatchError
+		if errors.As(err, &checksumErr) {
+			fmt.Println("The downloaded Spice.ai runtime failed checksum verification and was not installed. Please try again.")
+		} else {
+			fmt.Println("Error downloading Spice.ai runtime binaries.")
+		}
 		return err
 	}
 
@@ -123,6 +135,14 @@ func (c *MetalContext) InstallOrUpgradeRuntime() error {
 		return err
 	}
 
+	// A release newer than the cached latest means the cache is stale
+	if cachedTagName, ok := c.readRuntimeVersionCache(); ok && semver.Compare(ensureVersionPrefix(runtimeVersion), ensureVersionPrefix(runtimeTagVersion(cachedTagName))) > 0 {
+		err = os.Remove(c.runtimeVersionCachePath())
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
 	fmt.Printf("Spice runtime installed into %s successfully.\n", c.spiceBinDir)
 
 	return nil
@@ -139,15 +159,83 @@ func (c *MetalContext) IsRuntimeUpgradeAvailable() (string, error) {
 		return "", nil
 	}
 
-	tagName := "v" + currentVersion
-	release, err := github.GetLatestRuntimeRelease(tagName)
+	latestTagName, err := c.getLatestRuntimeTagName()
+	if err != nil {
+		return "", err
+	}
+
+	return runtimeUpgradeVersion(currentVersion, latestTagName, spice_version.Version()), nil
+}
+
+// runtimeUpgradeVersion returns the version to upgrade the installed runtime to, or "" when it is current.
+// InstallOrUpgradeRuntime installs the release matching the CLI, so nothing newer than the CLI is offered.
+func runtimeUpgradeVersion(installedVersion string, latestTagName string, cliVersion string) string {
+	latestVersion := ensureVersionPrefix(runtimeTagVersion(latestTagName))
+	if cliVersion != "local" && semver.Compare(latestVersion, ensureVersionPrefix(cliVersion)) > 0 {
+		latestVersion = ensureVersionPrefix(cliVersion)
+	}
+
+	if semver.Compare(latestVersion, ensureVersionPrefix(installedVersion)) > 0 {
+		return latestVersion
+	}
+
+	return ""
+}
+
+// semver requires a "v" prefix, which spiced includes in its version output but tags may not
+func ensureVersionPrefix(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+// Looking up releases is a GitHub round-trip, so the latest tag is cached for runtimeVersionCacheTTL
+func (c *MetalContext) getLatestRuntimeTagName() (string, error) {
+	if cachedTagName, ok := c.readRuntimeVersionCache(); ok {
+		return cachedTagName, nil
+	}
+
+	release, err := github.GetLatestRuntimeRelease("")
 	if err != nil {
 		return "", err
 	}
 
+	// Failing to write the cache just means GitHub is checked again next time
+	_ = c.writeRuntimeVersionCache(release.TagName)
+
 	return release.TagName, nil
 }
 
+func (c *MetalContext) readRuntimeVersionCache() (string, bool) {
+	cachePath := c.runtimeVersionCachePath()
+	stat, err := os.Stat(cachePath)
+	if err != nil || time.Since(stat.ModTime()) >= runtimeVersionCacheTTL {
+		return "", false
+	}
+
+	cachedTagName, err := os.ReadFile(cachePath)
+	if err != nil {
+		return "", false
+	}
+
+	tagName := strings.TrimSpace(string(cachedTagName))
+	return tagName, tagName != ""
+}
+
+func (c *MetalContext) writeRuntimeVersionCache(tagName string) error {
+	return os.WriteFile(c.runtimeVersionCachePath(), []byte(tagName), 0644)
+}
+
+func (c *MetalContext) runtimeVersionCachePath() string {
+	return filepath.Join(c.spiceRuntimeDir, runtimeVersionCacheFilename)
+}
+
+// Runtime release tags carry a "-spiced" suffix that semver would read as a pre-release
+func runtimeTagVersion(tagName string) string {
+	return strings.TrimSuffix(tagName, fmt.Sprintf("-%s", constants.SpiceRuntimeFilename))
+}
+
 func (c *MetalContext) GetSpiceAppRelativePath(absolutePath string) string {
 	if strings.HasPrefix(absolutePath, c.appDir) {
 		return absolutePath[len(c.appDir)+1:]
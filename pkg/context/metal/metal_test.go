@@ -0,0 +1,77 @@
+package metal
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeVersionCache(t *testing.T) {
+	t.Run("readRuntimeVersionCache() -- Returns a freshly written tag", testReadFreshRuntimeVersionCache())
+	t.Run("readRuntimeVersionCache() -- Ignores a tag older than the TTL", testReadExpiredRuntimeVersionCache())
+	t.Run("readRuntimeVersionCache() -- Misses when nothing is cached", testReadMissingRuntimeVersionCache())
+}
+
+func TestRuntimeUpgradeVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		installed string
+		latestTag string
+		cli       string
+		expected  string
+	}{
+		{name: "installed is latest", installed: "v0.5", latestTag: "v0.5-spiced", cli: "v0.5", expected: ""},
+		{name: "installed is older", installed: "v0.4", latestTag: "v0.5-spiced", cli: "v0.5", expected: "v0.5"},
+		{name: "installed is newer", installed: "v0.6", latestTag: "v0.5-spiced", cli: "v0.6", expected: ""},
+		{name: "installed without prefix", installed: "0.5", latestTag: "v0.5-spiced", cli: "v0.5", expected: ""},
+		{name: "capped at the CLI version", installed: "v0.4", latestTag: "v0.6-spiced", cli: "v0.5", expected: "v0.5"},
+		{name: "CLI matches installed", installed: "v0.5", latestTag: "v0.6-spiced", cli: "v0.5", expected: ""},
+		{name: "local CLI is not a cap", installed: "v0.4", latestTag: "v0.5-spiced", cli: "local", expected: "v0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, runtimeUpgradeVersion(tt.installed, tt.latestTag, tt.cli))
+		})
+	}
+}
+
+func testReadFreshRuntimeVersionCache() func(*testing.T) {
+	return func(t *testing.T) {
+		c := &MetalContext{spiceRuntimeDir: t.TempDir()}
+
+		err := c.writeRuntimeVersionCache("v0.5-spiced")
+		assert.NoError(t, err)
+
+		tagName, ok := c.readRuntimeVersionCache()
+		assert.True(t, ok)
+		assert.Equal(t, "v0.5-spiced", tagName)
+	}
+}
+
+func testReadExpiredRuntimeVersionCache() func(*testing.T) {
+	return func(t *testing.T) {
+		c := &MetalContext{spiceRuntimeDir: t.TempDir()}
+
+		err := c.writeRuntimeVersionCache("v0.5-spiced")
+		assert.NoError(t, err)
+
+		expired := time.Now().Add(-runtimeVersionCacheTTL - time.Minute)
+		err = os.Chtimes(c.runtimeVersionCachePath(), expired, expired)
+		assert.NoError(t, err)
+
+		_, ok := c.readRuntimeVersionCache()
+		assert.False(t, ok)
+	}
+}
+
+func testReadMissingRuntimeVersionCache() func(*testing.T) {
+	return func(t *testing.T) {
+		c := &MetalContext{spiceRuntimeDir: t.TempDir()}
+
+		_, ok := c.readRuntimeVersionCache()
+		assert.False(t, ok)
+	}
+}
@@ -1,14 +1,21 @@
 package environment
 
 import (
+	"context"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/spiceai/spiceai/pkg/aiengine"
 	"github.com/spiceai/spiceai/pkg/pods"
 )
 
-func StartDataListeners(intervalSecs int) error {
+var (
+	listenersWg sync.WaitGroup
+)
+
+// StartDataListeners polls for new data every intervalSecs until ctx is cancelled
+func StartDataListeners(ctx context.Context, intervalSecs int) error {
 	_, err := FetchNewData()
 	if err != nil {
 		log.Println(err)
@@ -17,8 +24,9 @@ func StartDataListeners(intervalSecs int) error {
 
 	// HACKHACK: Polled fetch for now (TODO data sources subscribe with push model)
 	ticker := time.NewTicker(time.Duration(intervalSecs) * time.Second)
-	quit := make(chan struct{})
+	listenersWg.Add(1)
 	go func() {
+		defer listenersWg.Done()
 		for {
 			select {
 			case <-ticker.C:
@@ -29,7 +37,7 @@ func StartDataListeners(intervalSecs int) error {
 						return
 					}
 				}
-			case <-quit:
+			case <-ctx.Done():
 				ticker.Stop()
 				return
 			}
@@ -39,8 +47,13 @@ func StartDataListeners(intervalSecs int) error {
 	return nil
 }
 
+// WaitForDataListeners blocks until all listeners have stopped after their context was cancelled
+func WaitForDataListeners() {
+	listenersWg.Wait()
+}
+
 func FetchNewData() (bool, error) {
-	for _, pod := range *pods.Pods() {
+	for _, pod := range pods.Pods() {
 		state, err := pod.State()
 		if err != nil {
 			log.Printf("%v", err)
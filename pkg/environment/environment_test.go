@@ -43,8 +43,11 @@ func testStartDataListeners() func(*testing.T) {
 			},
 		})
 
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
 		go func() {
-			err = environment.StartDataListeners(1)
+			err = environment.StartDataListeners(ctx, 1)
 			assert.NoError(t, err)
 		}()
 
@@ -1,15 +1,22 @@
 package github
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/spiceai/spiceai/pkg/util"
 )
 
+const (
+	checksumAssetExtension = ".sha256"
+)
+
 type ReleaseAsset struct {
 	URL                string `json:"url"`
 	BrowserDownloadURL string `json:"browser_download_url"`
@@ -31,25 +38,32 @@ func DownloadReleaseAsset(gh *GitHubClient, release *RepoRelease, assetName stri
 		return errors.New("no release assets found")
 	}
 
-	var asset *ReleaseAsset
-	for _, a := range release.Assets {
-		if a.Name == assetName {
-			asset = &a
-			break
-		}
-	}
-
+	asset := release.GetAsset(assetName)
 	if asset == nil {
-		return errors.New("no matching asset found")
+		return NewReleaseAssetNotFoundError(assetName)
 	}
 
-	assetUrl := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases/assets/%d", gh.Owner, gh.Repo, asset.ID)
-
-	body, err := gh.call("GET", assetUrl, nil, "application/octet-stream")
+	body, err := gh.download(getAssetUrl(gh, asset), "application/octet-stream")
 	if err != nil {
 		return err
 	}
 
+	// Releases that publish a "<asset>.sha256" file are verified before anything is written to disk
+	checksumAsset := release.GetAsset(assetName + checksumAssetExtension)
+	if checksumAsset != nil {
+		checksum, err := gh.call("GET", getAssetUrl(gh, checksumAsset), nil, "application/octet-stream")
+		if err != nil {
+			return fmt.Errorf("failed to download checksum for %s: %w", assetName, err)
+		}
+
+		err = verifyChecksum(assetName, body, checksum)
+		if err != nil {
+			return err
+		}
+	} else {
+		zaplog.Sugar().Warnf("release %s has no %s asset, installing %s without checksum verification", release.TagName, assetName+checksumAssetExtension, assetName)
+	}
+
 	ext := path.Ext(assetName)
 
 	switch ext {
@@ -62,3 +76,29 @@ func DownloadReleaseAsset(gh *GitHubClient, release *RepoRelease, assetName stri
 		return os.WriteFile(filePath, body, 0766)
 	}
 }
+
+func getAssetUrl(gh *GitHubClient, asset *ReleaseAsset) string {
+	return fmt.Sprintf("https://api.github.com/repos/%s/%s/releases/assets/%d", gh.Owner, gh.Repo, asset.ID)
+}
+
+// Checksum files follow the sha256sum format: "<hex digest>  <filename>"
+func verifyChecksum(assetName string, body []byte, checksum []byte) error {
+	fields := strings.Fields(string(checksum))
+	if len(fields) == 0 {
+		return fmt.Errorf("checksum for %s is empty", assetName)
+	}
+
+	expected := strings.ToLower(fields[0])
+
+	hash, err := util.ComputeHash(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(hash)
+	if actual != expected {
+		return NewChecksumMismatchError(assetName, expected, actual)
+	}
+
+	return nil
+}
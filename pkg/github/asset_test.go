@@ -0,0 +1,44 @@
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyChecksum(t *testing.T) {
+	body := []byte("spiced binary contents")
+	digest := sha256.Sum256(body)
+	expected := hex.EncodeToString(digest[:])
+
+	tests := []struct {
+		name         string
+		checksum     string
+		wantErr      bool
+		wantMismatch bool
+	}{
+		{name: "matching digest", checksum: expected},
+		{name: "upper-case digest", checksum: strings.ToUpper(expected)},
+		{name: "sha256sum format", checksum: expected + "  spiced_linux_amd64.tar.gz\n"},
+		{name: "mismatched digest", checksum: strings.Repeat("0", 64), wantErr: true, wantMismatch: true},
+		{name: "empty checksum", checksum: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyChecksum("spiced_linux_amd64.tar.gz", body, []byte(tt.checksum))
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			var mismatchErr *ChecksumMismatchError
+			assert.Equal(t, tt.wantMismatch, errors.As(err, &mismatchErr))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package github
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/spiceai/spiceai/pkg/constants"
+)
+
+func GetLatestCliRelease() (*RepoRelease, error) {
+	if offline {
+		return nil, ErrOffline
+	}
+
+	release, err := GetLatestRelease(githubClient, "", GetCliAssetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func GetCliVersion(release *RepoRelease) string {
+	return strings.TrimSuffix(release.TagName, fmt.Sprintf("-%s", constants.SpiceCliFilename))
+}
+
+func GetCliAssetName() string {
+	return fmt.Sprintf("%s_%s_%s.tar.gz", constants.SpiceCliFilename, runtime.GOOS, runtime.GOARCH)
+}
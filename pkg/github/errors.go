@@ -1,5 +1,7 @@
 package github
 
+import "fmt"
+
 type GitHubCallError struct {
 	StatusCode int
 	Message    string
@@ -15,3 +17,35 @@ func NewGitHubCallError(message string, statusCode int) *GitHubCallError {
 		StatusCode: statusCode,
 	}
 }
+
+type ChecksumMismatchError struct {
+	AssetName string
+	Expected  string
+	Actual    string
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %s: expected %s, got %s", e.AssetName, e.Expected, e.Actual)
+}
+
+func NewChecksumMismatchError(assetName string, expected string, actual string) *ChecksumMismatchError {
+	return &ChecksumMismatchError{
+		AssetName: assetName,
+		Expected:  expected,
+		Actual:    actual,
+	}
+}
+
+type ReleaseAssetNotFoundError struct {
+	AssetName string
+}
+
+func (e *ReleaseAssetNotFoundError) Error() string {
+	return fmt.Sprintf("no release contains the asset %s", e.AssetName)
+}
+
+func NewReleaseAssetNotFoundError(assetName string) *ReleaseAssetNotFoundError {
+	return &ReleaseAssetNotFoundError{
+		AssetName: assetName,
+	}
+}
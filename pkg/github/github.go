@@ -2,18 +2,48 @@ package github
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/spiceai/spiceai/pkg/loggers"
 	"github.com/spiceai/spiceai/pkg/util"
+	"go.uber.org/zap"
+)
+
+const (
+	gitHubTokenEnvVar       = "GITHUB_TOKEN"
+	gitHubMaxAttemptsEnvVar = "SPICE_GITHUB_MAX_ATTEMPTS"
+	defaultMaxAttempts      = 3
+)
+
+var (
+	zaplog              *zap.Logger = loggers.ZapLogger()
+	offline             bool
+	initialRetryBackoff = time.Second
+
+	ErrOffline = errors.New("offline mode is enabled, skipping call to GitHub")
 )
 
 type GitHubClient struct {
 	Owner string
 	Repo  string
+	// Number of times a failed GET is attempted before giving up
+	MaxAttempts int
+}
+
+// SetOffline prevents any calls to GitHub from being made
+func SetOffline(isOffline bool) {
+	offline = isOffline
+}
+
+func IsOffline() bool {
+	return offline
 }
 
 func NewGitHubClientFromPath(path string) (*GitHubClient, error) {
@@ -31,17 +61,34 @@ func NewGitHubClientFromPath(path string) (*GitHubClient, error) {
 
 func NewGitHubClient(owner string, repo string) *GitHubClient {
 	return &GitHubClient{
-		Owner: owner,
-		Repo:  repo,
+		Owner:       owner,
+		Repo:        repo,
+		MaxAttempts: maxAttempts(),
 	}
 }
 
+// maxAttempts can be raised with SPICE_GITHUB_MAX_ATTEMPTS on unreliable networks
+func maxAttempts() int {
+	value := os.Getenv(gitHubMaxAttemptsEnvVar)
+	if value == "" {
+		return defaultMaxAttempts
+	}
+
+	attempts, err := strconv.Atoi(value)
+	if err != nil || attempts < 1 {
+		zaplog.Sugar().Warnf("ignoring invalid %s '%s', using %d", gitHubMaxAttemptsEnvVar, value, defaultMaxAttempts)
+		return defaultMaxAttempts
+	}
+
+	return attempts
+}
+
 func (g *GitHubClient) Get(url string, payload []byte) ([]byte, error) {
 	return g.call("GET", url, payload, "application/vnd.github.v3+json")
 }
 
 func (g *GitHubClient) DownloadFile(url string, downloadPath string) error {
-	body, err := g.Get(url, nil)
+	body, err := g.download(url, "application/vnd.github.v3+json")
 	if err != nil {
 		return err
 	}
@@ -50,7 +97,7 @@ func (g *GitHubClient) DownloadFile(url string, downloadPath string) error {
 }
 
 func (g *GitHubClient) DownloadTarGzip(url string, downloadDir string) error {
-	body, err := g.Get(url, nil)
+	body, err := g.download(url, "application/vnd.github.v3+json")
 	if err != nil {
 		return err
 	}
@@ -59,6 +106,43 @@ func (g *GitHubClient) DownloadTarGzip(url string, downloadDir string) error {
 }
 
 func (g *GitHubClient) call(method string, url string, payload []byte, accept string) ([]byte, error) {
+	return g.callWithRetry(method, url, payload, accept, false)
+}
+
+// download is a GET that reports progress to the terminal as the body is received
+func (g *GitHubClient) download(url string, accept string) ([]byte, error) {
+	return g.callWithRetry("GET", url, nil, accept, true)
+}
+
+func (g *GitHubClient) callWithRetry(method string, url string, payload []byte, accept string, showProgress bool) ([]byte, error) {
+	if offline {
+		return nil, ErrOffline
+	}
+
+	// Only GETs are safe to retry
+	if method != "GET" {
+		return g.callOnce(method, url, payload, accept, nil, showProgress)
+	}
+
+	var body []byte
+	var err error
+	backoff := initialRetryBackoff
+	for attempt := 1; ; attempt++ {
+		// Any bytes received before a failure are kept so the next attempt can resume from them
+		body, err = g.callOnce(method, url, payload, accept, body, showProgress)
+		if err == nil || attempt >= g.MaxAttempts || !isRetryable(err) {
+			return body, err
+		}
+
+		zaplog.Sugar().Debugf("GitHub call to %s failed (attempt %d of %d), retrying in %s: %s", url, attempt, g.MaxAttempts, backoff, err.Error())
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// callOnce makes a single request and appends the response body to received.
+// When received is non-empty, a Range request is made to resume from where the last attempt stopped.
+func (g *GitHubClient) callOnce(method string, url string, payload []byte, accept string, received []byte, showProgress bool) ([]byte, error) {
 	if payload == nil {
 		payload = make([]byte, 0)
 	}
@@ -67,28 +151,93 @@ func (g *GitHubClient) call(method string, url string, payload []byte, accept st
 
 	req, err := http.NewRequest(method, url, payloadReader)
 	if err != nil {
-		return nil, err
+		return received, err
 	}
 
 	if accept != "" {
 		req.Header.Add("Accept", accept)
 	}
 
+	// Authenticated requests get a much higher rate limit than anonymous ones
+	if token := os.Getenv(gitHubTokenEnvVar); token != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+
+	if len(received) > 0 {
+		req.Header.Add("Range", fmt.Sprintf("bytes=%d-", len(received)))
+	}
+
 	response, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, err
+		return received, err
 	}
 
 	defer response.Body.Close()
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
+	if response.StatusCode != http.StatusPartialContent {
+		// The server ignored the Range header, so start over
+		received = received[:0]
 	}
 
-	if response.StatusCode != 200 {
+	var bodyReader io.Reader = response.Body
+	if showProgress && response.StatusCode < 300 {
+		progress := newProgressReader(response.Body, int64(len(received)), response.ContentLength)
+		defer progress.Done()
+		bodyReader = progress
+	}
+
+	body, err := io.ReadAll(bodyReader)
+
+	if response.StatusCode != 200 && response.StatusCode != http.StatusPartialContent {
+		// An error page is never part of the content, so nothing is kept to resume from
+		if err != nil {
+			return nil, err
+		}
+
+		if isRateLimited(response) {
+			return nil, NewGitHubCallError(rateLimitMessage(response), response.StatusCode)
+		}
+
 		return nil, NewGitHubCallError(fmt.Sprintf("Error calling GitHub: %s", string(body)), response.StatusCode)
 	}
 
-	return body, nil
+	received = append(received, body...)
+	if err != nil {
+		return received, err
+	}
+
+	return received, nil
+}
+
+// Network errors and server-side failures are worth retrying, anything else will fail the same way again
+func isRetryable(err error) bool {
+	var callErr *GitHubCallError
+	if errors.As(err, &callErr) {
+		return callErr.StatusCode >= 500
+	}
+
+	return true
+}
+
+func isRateLimited(response *http.Response) bool {
+	if response.StatusCode != http.StatusForbidden && response.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+
+	return response.Header.Get("X-RateLimit-Remaining") == "0"
+}
+
+func rateLimitMessage(response *http.Response) string {
+	message := "GitHub API rate limit exceeded"
+
+	reset, err := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err == nil {
+		message = fmt.Sprintf("%s, the limit resets at %s", message, time.Unix(reset, 0).Local().Format(time.Kitchen))
+	}
+
+	if os.Getenv(gitHubTokenEnvVar) == "" {
+		message = fmt.Sprintf("%s. Set %s to authenticate with GitHub and raise the limit", message, gitHubTokenEnvVar)
+	}
+
+	return message
 }
@@ -0,0 +1,161 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitHubClientRetry(t *testing.T) {
+	originalBackoff := initialRetryBackoff
+	initialRetryBackoff = time.Millisecond
+	t.Cleanup(func() {
+		initialRetryBackoff = originalBackoff
+	})
+
+	t.Run("call() -- Resumes an interrupted download with a Range request", testCallResumesPartialBody())
+	t.Run("call() -- Starts over when the server ignores Range", testCallRestartsWhenRangeIgnored())
+	t.Run("call() -- Retries server errors", testCallRetriesServerErrors())
+	t.Run("call() -- Does not resume from a truncated error page", testCallDiscardsTruncatedErrorPage())
+	t.Run("call() -- Does not retry client errors", testCallDoesNotRetryClientErrors())
+	t.Run("maxAttempts() -- Reads SPICE_GITHUB_MAX_ATTEMPTS", testMaxAttemptsFromEnv())
+}
+
+// writeTruncatedResponse promises the full body but closes the connection after the first part of it
+func writeTruncatedResponse(t *testing.T, w http.ResponseWriter, statusCode int, body string, sent int) {
+	conn, buf, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, _ = fmt.Fprintf(buf, "HTTP/1.1 %d %s\r\nContent-Length: %d\r\n\r\n%s", statusCode, http.StatusText(statusCode), len(body), body[:sent])
+	_ = buf.Flush()
+}
+
+func testCallResumesPartialBody() func(*testing.T) {
+	return func(t *testing.T) {
+		body := "helloworld"
+		var requests int32
+		var rangeHeader string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				writeTruncatedResponse(t, w, http.StatusOK, body, 5)
+				return
+			}
+			rangeHeader = r.Header.Get("Range")
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write([]byte(body[5:]))
+		}))
+		defer server.Close()
+
+		gh := NewGitHubClient("spiceai", "spiceai")
+		result, err := gh.call("GET", server.URL, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "bytes=5-", rangeHeader)
+		assert.Equal(t, body, string(result))
+	}
+}
+
+func testCallRestartsWhenRangeIgnored() func(*testing.T) {
+	return func(t *testing.T) {
+		body := "helloworld"
+		var requests int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				writeTruncatedResponse(t, w, http.StatusOK, body, 5)
+				return
+			}
+			_, _ = w.Write([]byte(body))
+		}))
+		defer server.Close()
+
+		gh := NewGitHubClient("spiceai", "spiceai")
+		result, err := gh.call("GET", server.URL, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, body, string(result))
+	}
+}
+
+func testCallRetriesServerErrors() func(*testing.T) {
+	return func(t *testing.T) {
+		var requests int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		gh := NewGitHubClient("spiceai", "spiceai")
+		result, err := gh.call("GET", server.URL, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", string(result))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	}
+}
+
+func testCallDiscardsTruncatedErrorPage() func(*testing.T) {
+	return func(t *testing.T) {
+		body := "helloworld"
+		var requests int32
+		var rangeHeader string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				writeTruncatedResponse(t, w, http.StatusBadGateway, "<html>bad gateway</html>", 6)
+				return
+			}
+			rangeHeader = r.Header.Get("Range")
+			_, _ = w.Write([]byte(body))
+		}))
+		defer server.Close()
+
+		gh := NewGitHubClient("spiceai", "spiceai")
+		result, err := gh.call("GET", server.URL, nil, "")
+		assert.NoError(t, err)
+		assert.Empty(t, rangeHeader)
+		assert.Equal(t, body, string(result))
+	}
+}
+
+func testCallDoesNotRetryClientErrors() func(*testing.T) {
+	return func(t *testing.T) {
+		var requests int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		gh := NewGitHubClient("spiceai", "spiceai")
+		_, err := gh.call("GET", server.URL, nil, "")
+
+		var callErr *GitHubCallError
+		if assert.ErrorAs(t, err, &callErr) {
+			assert.Equal(t, http.StatusNotFound, callErr.StatusCode)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	}
+}
+
+func testMaxAttemptsFromEnv() func(*testing.T) {
+	return func(t *testing.T) {
+		t.Setenv(gitHubMaxAttemptsEnvVar, "5")
+		assert.Equal(t, 5, NewGitHubClient("spiceai", "spiceai").MaxAttempts)
+
+		t.Setenv(gitHubMaxAttemptsEnvVar, "zero")
+		assert.Equal(t, defaultMaxAttempts, NewGitHubClient("spiceai", "spiceai").MaxAttempts)
+	}
+}
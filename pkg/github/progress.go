@@ -0,0 +1,121 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	progressRefreshInterval = 100 * time.Millisecond
+	progressBarWidth        = 30
+)
+
+var (
+	spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+)
+
+// progressReader draws a progress bar on stdout as the wrapped reader is consumed.
+// When the total size is unknown it falls back to a spinner, and when stdout isn't
+// a terminal nothing is drawn at all.
+type progressReader struct {
+	reader      io.Reader
+	enabled     bool
+	offset      int64
+	total       int64
+	read        int64
+	start       time.Time
+	lastDrawn   time.Time
+	spinnerStep int
+}
+
+// offset is the number of bytes already received by a previous attempt and
+// contentLength is the remaining length reported by the server (-1 if unknown)
+func newProgressReader(reader io.Reader, offset int64, contentLength int64) *progressReader {
+	total := int64(-1)
+	if contentLength >= 0 {
+		total = offset + contentLength
+	}
+
+	return &progressReader{
+		reader:  reader,
+		enabled: isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()),
+		offset:  offset,
+		total:   total,
+		start:   time.Now(),
+	}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	p.read += int64(n)
+
+	if p.enabled && time.Since(p.lastDrawn) >= progressRefreshInterval {
+		p.draw()
+	}
+
+	return n, err
+}
+
+// Done draws the final state and moves the cursor off the progress line
+func (p *progressReader) Done() {
+	if !p.enabled {
+		return
+	}
+
+	p.draw()
+	fmt.Println()
+}
+
+func (p *progressReader) draw() {
+	p.lastDrawn = time.Now()
+
+	received := p.offset + p.read
+	elapsed := time.Since(p.start).Seconds()
+	speed := float64(0)
+	if elapsed > 0 {
+		speed = float64(p.read) / elapsed
+	}
+
+	if p.total <= 0 {
+		frame := spinnerFrames[p.spinnerStep%len(spinnerFrames)]
+		p.spinnerStep++
+		fmt.Printf("\r%s Downloading %s (%s/s)        ", frame, formatBytes(received), formatBytes(int64(speed)))
+		return
+	}
+
+	fraction := float64(received) / float64(p.total)
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	filled := int(fraction * progressBarWidth)
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled)
+
+	eta := "--"
+	if speed > 0 {
+		remaining := time.Duration(float64(p.total-received)/speed) * time.Second
+		eta = remaining.Round(time.Second).String()
+	}
+
+	fmt.Printf("\r[%s] %3.0f%% %s of %s (%s/s, ETA %s)        ", bar, fraction*100, formatBytes(received), formatBytes(p.total), formatBytes(int64(speed)), eta)
+}
+
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -57,6 +57,16 @@ func (r *RepoRelease) HasAsset(assetName string) bool {
 	return false
 }
 
+func (r *RepoRelease) GetAsset(assetName string) *ReleaseAsset {
+	for i := range r.Assets {
+		if r.Assets[i].Name == assetName {
+			return &r.Assets[i]
+		}
+	}
+
+	return nil
+}
+
 func GetReleases(gh *GitHubClient) (RepoReleases, error) {
 	releasesURL := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases", gh.Owner, gh.Repo)
 	body, err := gh.Get(releasesURL, nil)
@@ -86,16 +96,23 @@ func GetLatestRelease(gh *GitHubClient, tagName string, assetName string) (*Repo
 	// Sort by semver in descending order
 	sort.Sort(releases)
 
+	matchedTag := false
 	for _, release := range releases {
 		if tagName != "" && release.TagName != tagName {
 			continue
 		}
+		matchedTag = true
 		if assetName != "" && !release.HasAsset(assetName) {
 			continue
 		}
 		return &release, nil
 	}
 
+	// Releases exist, they just weren't built for this asset
+	if matchedTag && assetName != "" {
+		return nil, NewReleaseAssetNotFoundError(assetName)
+	}
+
 	return nil, fmt.Errorf("no releases")
 }
 
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"errors"
 	"fmt"
 	"runtime"
 	"strings"
@@ -19,11 +20,15 @@ const (
 )
 
 func GetLatestRuntimeRelease(tagName string) (*RepoRelease, error) {
+	if offline {
+		return nil, ErrOffline
+	}
+
 	fmt.Println("Checking for latest Spice runtime release...")
 
 	release, err := GetLatestRelease(githubClient, tagName, GetRuntimeAssetName())
 	if err != nil {
-		return nil, err
+		return nil, platformAssetError(err)
 	}
 
 	return release, nil
@@ -35,7 +40,22 @@ func GetRuntimeVersion(release *RepoRelease) string {
 
 func DownloadRuntimeAsset(release *RepoRelease, downloadPath string) error {
 	assetName := GetRuntimeAssetName()
-	return DownloadReleaseAsset(githubClient, release, assetName, downloadPath)
+	err := DownloadReleaseAsset(githubClient, release, assetName, downloadPath)
+	if err != nil {
+		return platformAssetError(err)
+	}
+
+	return nil
+}
+
+// Not every platform is published (e.g. windows/arm64), so say so rather than reporting a generic download failure
+func platformAssetError(err error) error {
+	var assetErr *ReleaseAssetNotFoundError
+	if errors.As(err, &assetErr) {
+		return fmt.Errorf("no Spice.ai runtime build is available for %s/%s: %w", runtime.GOOS, runtime.GOARCH, err)
+	}
+
+	return err
 }
 
 func GetRuntimeAssetName() string {
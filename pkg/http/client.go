@@ -8,6 +8,10 @@ import (
 	"github.com/spiceai/spiceai/pkg/version"
 )
 
+const (
+	requestIdHeader = "X-Request-Id"
+)
+
 var _userAgent string
 
 func Get(url string) (*net_http.Response, error) {
@@ -17,6 +21,7 @@ func Get(url string) (*net_http.Response, error) {
 	}
 
 	req.Header.Set("User-Agent", userAgent())
+	zaplog.Sugar().Debugf("GET %s with User-Agent '%s'", url, req.Header.Get("User-Agent"))
 
 	resp, err := net_http.DefaultClient.Do(req)
 	if err != nil {
@@ -26,6 +31,16 @@ func Get(url string) (*net_http.Response, error) {
 	return resp, nil
 }
 
+// NewResponseError describes a failed response, including the server's request ID
+// when one was returned so the failure can be correlated with server logs
+func NewResponseError(message string, resp *net_http.Response) error {
+	requestId := resp.Header.Get(requestIdHeader)
+	if requestId == "" {
+		return fmt.Errorf("%s: %s", message, resp.Status)
+	}
+	return fmt.Errorf("%s: %s (request id: %s)", message, resp.Status, requestId)
+}
+
 func userAgent() string {
 	if _userAgent == "" {
 		_userAgent = fmt.Sprintf("Spice.ai/%s %s/%s (%s)", version.Version(), version.Component(), version.Version(), runtime.GOOS)
@@ -0,0 +1,38 @@
+package http
+
+import (
+	net_http "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("NewResponseError() -- Includes the request ID when present", testNewResponseErrorWithRequestId())
+	t.Run("NewResponseError() -- Omits the request ID when absent", testNewResponseErrorWithoutRequestId())
+}
+
+func testNewResponseErrorWithRequestId() func(t *testing.T) {
+	return func(t *testing.T) {
+		resp := &net_http.Response{
+			Status: "500 Internal Server Error",
+			Header: net_http.Header{},
+		}
+		resp.Header.Set("X-Request-Id", "abc123")
+
+		err := NewResponseError("failed to fetch pod", resp)
+		assert.EqualError(t, err, "failed to fetch pod: 500 Internal Server Error (request id: abc123)")
+	}
+}
+
+func testNewResponseErrorWithoutRequestId() func(t *testing.T) {
+	return func(t *testing.T) {
+		resp := &net_http.Response{
+			Status: "503 Service Unavailable",
+			Header: net_http.Header{},
+		}
+
+		err := NewResponseError("failed to fetch pod", resp)
+		assert.EqualError(t, err, "failed to fetch pod: 503 Service Unavailable")
+	}
+}
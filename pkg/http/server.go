@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -23,12 +24,22 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// Idle keep-alive connections, such as an open dashboard, would otherwise hold up Shutdown() indefinitely
+	defaultIdleTimeout = 5 * time.Second
+	defaultReadTimeout = 60 * time.Second
+)
+
 type ServerConfig struct {
-	Port uint
+	Port        uint
+	IdleTimeout time.Duration
+	ReadTimeout time.Duration
 }
 
 type server struct {
 	config ServerConfig
+	// Closed once the server has shut down and in-flight requests have completed
+	done chan struct{}
 }
 
 var (
@@ -102,11 +113,9 @@ func apiPostObservationsHandler(ctx *fasthttp.RequestCtx) {
 }
 
 func apiPodsHandler(ctx *fasthttp.RequestCtx) {
-	pods := pods.Pods()
-
 	data := make([]*runtime_pb.Pod, 0)
 
-	for _, f := range *pods {
+	for _, f := range pods.Pods() {
 		if f == nil {
 			continue
 		}
@@ -447,12 +456,16 @@ func apiPostImportHandler(ctx *fasthttp.RequestCtx) {
 func NewServer(port uint) *server {
 	return &server{
 		config: ServerConfig{
-			Port: port,
+			Port:        port,
+			IdleTimeout: defaultIdleTimeout,
+			ReadTimeout: defaultReadTimeout,
 		},
+		done: make(chan struct{}),
 	}
 }
 
-func (server *server) Start() error {
+// Start serves the runtime API and dashboard until ctx is cancelled
+func (server *server) Start(ctx context.Context) error {
 	r := router.New()
 	r.GET("/health", healthHandler)
 
@@ -507,13 +520,34 @@ func (server *server) Start() error {
 		return fmt.Errorf("failed to initialize logger: %w", err)
 	}
 	fastServer := &fasthttp.Server{
-		Handler: r.Handler,
-		Logger:  serverLogger,
+		Handler:     r.Handler,
+		Logger:      serverLogger,
+		IdleTimeout: server.config.IdleTimeout,
+		ReadTimeout: server.config.ReadTimeout,
 	}
 
 	go func() {
-		log.Fatal(fastServer.ListenAndServe(fmt.Sprintf(":%d", server.config.Port)))
+		// ListenAndServe returns nil once Shutdown() is called
+		err := fastServer.ListenAndServe(fmt.Sprintf(":%d", server.config.Port))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	go func() {
+		defer close(server.done)
+		<-ctx.Done()
+		// Shutdown waits for open connections, so requests such as imports finish before returning
+		err := fastServer.Shutdown()
+		if err != nil {
+			zaplog.Sugar().Debug(err.Error())
+		}
 	}()
 
 	return nil
 }
+
+// Done is closed after ctx passed to Start is cancelled and the server has finished shutting down
+func (server *server) Done() <-chan struct{} {
+	return server.done
+}
@@ -1,8 +1,14 @@
 package http
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/spiceai/spiceai/pkg/api"
 	"github.com/spiceai/spiceai/pkg/interpretations"
@@ -22,6 +28,7 @@ func TestServer(t *testing.T) {
 
 	t.Run("getInterpretations()", testGetInterpretationsHandlerFunc(pod))
 	t.Run("postInterpretations()", testPostInterpretationsHandlerFunc(pod))
+	t.Run("Start() -- Shuts down with an idle keep-alive connection open", testShutdownWithIdleConnection())
 }
 
 func testGetInterpretationsHandlerFunc(pod *pods.Pod) func(t *testing.T) {
@@ -85,3 +92,46 @@ func testPostInterpretationsHandlerFunc(pod *pods.Pod) func(t *testing.T) {
 		assert.Equal(t, interpretation, &interpretations[0])
 	}
 }
+
+func testShutdownWithIdleConnection() func(t *testing.T) {
+	return func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		port := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		server := NewServer(uint(port))
+		server.config.IdleTimeout = 200 * time.Millisecond
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err = server.Start(ctx)
+		assert.NoError(t, err)
+
+		var conn net.Conn
+		assert.Eventually(t, func() bool {
+			conn, err = net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+			return err == nil
+		}, 2*time.Second, 10*time.Millisecond)
+		defer conn.Close()
+
+		// Complete one request and leave the keep-alive connection idle
+		_, err = fmt.Fprintf(conn, "GET /api/v0.1/pods HTTP/1.1\r\nHost: localhost\r\n\r\n")
+		assert.NoError(t, err)
+		response, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if assert.NoError(t, err) {
+			response.Body.Close()
+		}
+
+		cancel()
+
+		select {
+		case <-server.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("server did not shut down with an idle connection open")
+		}
+	}
+}
@@ -4,12 +4,23 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync/atomic"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	LogFormatText = "text"
+	LogFormatJson = "json"
 )
 
 var (
 	zapLogger *zap.Logger
+	// Packages hold on to the logger from ZapLogger() from initialization onwards,
+	// so Configure() swaps the core it writes through rather than the logger itself
+	zapCore   *swappableCore
+	logFormat string = LogFormatText
 )
 
 func ZapLogger() *zap.Logger {
@@ -17,18 +28,23 @@ func ZapLogger() *zap.Logger {
 		return zapLogger
 	}
 
-	var err error
-	if os.Getenv("SPICE_DEBUG") == "1" {
-		zapLogger, err = zap.NewDevelopment()
-	} else {
-		zapLogger, err = zap.NewProduction()
-	}
+	core, err := newCore(LogFormatText)
 	if err != nil {
 		// Fall back to standard logging
 		log.Println(fmt.Errorf("unable to create Zap logger: %w", err))
 		return nil
 	}
 
+	zapCore = &swappableCore{}
+	zapCore.swap(core)
+
+	// Same options zap.NewProduction() and zap.NewDevelopment() apply
+	if isDebug() {
+		zapLogger = zap.New(zapCore, zap.AddCaller(), zap.Development(), zap.AddStacktrace(zap.WarnLevel))
+	} else {
+		zapLogger = zap.New(zapCore, zap.AddCaller(), zap.AddStacktrace(zap.ErrorLevel))
+	}
+
 	return zapLogger
 }
 
@@ -42,3 +58,90 @@ func ZapLoggerSync() {
 		}
 	}
 }
+
+// Configure switches the shared logger to the given format, either "text" or "json".
+// It is called once by spiced after its flags are parsed.
+func Configure(format string) error {
+	if format != LogFormatText && format != LogFormatJson {
+		return fmt.Errorf("invalid log format '%s', must be either '%s' or '%s'", format, LogFormatText, LogFormatJson)
+	}
+
+	if ZapLogger() == nil {
+		return fmt.Errorf("unable to create Zap logger")
+	}
+
+	core, err := newCore(format)
+	if err != nil {
+		return fmt.Errorf("unable to create %s logger: %w", format, err)
+	}
+
+	zapCore.swap(core)
+	logFormat = format
+
+	return nil
+}
+
+func IsJsonLogFormat() bool {
+	return logFormat == LogFormatJson
+}
+
+func newCore(format string) (zapcore.Core, error) {
+	var config zap.Config
+	switch {
+	case format == LogFormatJson:
+		config = zap.NewProductionConfig()
+		if isDebug() {
+			config.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
+		}
+	case isDebug():
+		config = zap.NewDevelopmentConfig()
+	default:
+		config = zap.NewProductionConfig()
+		config.Encoding = "console"
+		config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	}
+
+	logger, err := config.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return logger.Core(), nil
+}
+
+func isDebug() bool {
+	return os.Getenv("SPICE_DEBUG") == "1"
+}
+
+// swappableCore forwards to a core that can be replaced while loggers are in use
+type swappableCore struct {
+	core atomic.Value
+}
+
+func (c *swappableCore) swap(core zapcore.Core) {
+	c.core.Store(&core)
+}
+
+func (c *swappableCore) current() zapcore.Core {
+	return *c.core.Load().(*zapcore.Core)
+}
+
+func (c *swappableCore) Enabled(level zapcore.Level) bool {
+	return c.current().Enabled(level)
+}
+
+func (c *swappableCore) With(fields []zapcore.Field) zapcore.Core {
+	return c.current().With(fields)
+}
+
+func (c *swappableCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return c.current().Check(entry, checked)
+}
+
+func (c *swappableCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.current().Write(entry, fields)
+}
+
+func (c *swappableCore) Sync() error {
+	return c.current().Sync()
+}
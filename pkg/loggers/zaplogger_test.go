@@ -0,0 +1,36 @@
+package loggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() {
+		_ = Configure(LogFormatText)
+	})
+
+	logger := ZapLogger()
+	if !assert.NotNil(t, logger) {
+		return
+	}
+
+	t.Run("Configure() -- Switches to JSON", func(t *testing.T) {
+		assert.NoError(t, Configure(LogFormatJson))
+		assert.True(t, IsJsonLogFormat())
+		// Loggers handed out earlier keep working with the new core
+		assert.Same(t, logger, ZapLogger())
+		logger.Info("configured json logging")
+	})
+
+	t.Run("Configure() -- Switches back to text", func(t *testing.T) {
+		assert.NoError(t, Configure(LogFormatText))
+		assert.False(t, IsJsonLogFormat())
+	})
+
+	t.Run("Configure() -- Rejects an unknown format", func(t *testing.T) {
+		assert.Error(t, Configure("xml"))
+		assert.False(t, IsJsonLogFormat())
+	})
+}
@@ -5,27 +5,46 @@ import (
 	"io/ioutil"
 	"log"
 	"path/filepath"
+	"sync"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/util"
 )
 
-var pods = make(map[string]*Pod)
+var (
+	pods      = make(map[string]*Pod)
+	podsMutex sync.RWMutex
+)
+
+// Pods returns a snapshot of the loaded pods, safe to range over while pods are being added or removed
+func Pods() []*Pod {
+	podsMutex.RLock()
+	defer podsMutex.RUnlock()
+
+	snapshot := make([]*Pod, 0, len(pods))
+	for _, pod := range pods {
+		snapshot = append(snapshot, pod)
+	}
 
-func Pods() *map[string]*Pod {
-	return &pods
+	return snapshot
 }
 
 func CreateOrUpdatePod(pod *Pod) {
+	podsMutex.Lock()
+	defer podsMutex.Unlock()
 	pods[pod.Name] = pod
 }
 
 func GetPod(name string) *Pod {
+	podsMutex.RLock()
+	defer podsMutex.RUnlock()
 	return pods[name]
 }
 
 func RemovePod(name string) {
+	podsMutex.Lock()
+	defer podsMutex.Unlock()
 	delete(pods, name)
 }
 
@@ -50,10 +69,13 @@ func FindPod(podName string) (*Pod, error) {
 
 func RemovePodByManifestPath(manifestPath string) {
 	relativePath := context.CurrentContext().GetSpiceAppRelativePath(manifestPath)
+
+	podsMutex.Lock()
+	defer podsMutex.Unlock()
 	for _, pod := range pods {
 		if pod.ManifestPath() == manifestPath {
 			log.Printf("Removing pod %s: %s\n", aurora.Bold(pod.Name), aurora.Gray(12, relativePath))
-			RemovePod(pod.Name)
+			delete(pods, pod.Name)
 			return
 		}
 	}
@@ -11,3 +11,15 @@ func NewRegistryItemNotFound(err error) *RegistryItemNotFound {
 		Err: err,
 	}
 }
+
+type RegistryItemVersionNotFound struct {
+	Err error
+}
+
+func (e *RegistryItemVersionNotFound) Error() string { return e.Err.Error() }
+
+func NewRegistryItemVersionNotFound(err error) *RegistryItemVersionNotFound {
+	return &RegistryItemVersionNotFound{
+		Err: err,
+	}
+}
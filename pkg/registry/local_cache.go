@@ -0,0 +1,79 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spiceai/spiceai/pkg/constants"
+	"github.com/spiceai/spiceai/pkg/context"
+	"github.com/spiceai/spiceai/pkg/util"
+)
+
+const (
+	registryCacheDirectoryName = "registry"
+	latestPodVersion           = "latest"
+)
+
+// LocalCacheRegistry serves pods previously fetched from spicerack.org out of ~/.spice/registry
+type LocalCacheRegistry struct{}
+
+func (r *LocalCacheRegistry) GetPod(podFullPath string) (string, error) {
+	podPath, podVersion := splitPodVersion(podFullPath)
+	manifestFileName := podManifestFileName(podPath)
+
+	cachedManifestPath := filepath.Join(cachedPodDir(podPath, podVersion), manifestFileName)
+	manifest, err := os.ReadFile(cachedManifestPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", NewRegistryItemNotFound(fmt.Errorf("pod %s not found in the local cache", podFullPath))
+		}
+		return "", fmt.Errorf("error reading cached pod %s: %w", podFullPath, err)
+	}
+
+	podsDir := context.CurrentContext().PodsDir()
+	_, err = util.MkDirAllInheritPerm(podsDir)
+	if err != nil {
+		return "", fmt.Errorf("error fetching pod %s: %w", podFullPath, err)
+	}
+
+	podManifestPath := filepath.Join(podsDir, manifestFileName)
+	err = os.WriteFile(podManifestPath, manifest, 0644)
+	if err != nil {
+		return "", fmt.Errorf("error fetching pod %s", podFullPath)
+	}
+
+	return podManifestPath, nil
+}
+
+// cachePod stores a manifest fetched from spicerack.org so it can be added again without network access
+func cachePod(podPath string, podVersion string, manifest []byte) error {
+	podCacheDir := cachedPodDir(podPath, podVersion)
+	err := os.MkdirAll(podCacheDir, 0766)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(podCacheDir, podManifestFileName(podPath)), manifest, 0644)
+}
+
+func cachedPodDir(podPath string, podVersion string) string {
+	if podVersion == "" {
+		podVersion = latestPodVersion
+	}
+	return filepath.Join(os.Getenv("HOME"), constants.DotSpice, registryCacheDirectoryName, filepath.FromSlash(podPath), podVersion)
+}
+
+func splitPodVersion(podFullPath string) (string, string) {
+	parts := strings.Split(podFullPath, "@")
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return podFullPath, ""
+}
+
+func podManifestFileName(podPath string) string {
+	return fmt.Sprintf("%s.yaml", strings.ToLower(filepath.Base(podPath)))
+}
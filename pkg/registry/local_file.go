@@ -30,9 +30,9 @@ func (r *LocalFileRegistry) GetPod(podPath string) (string, error) {
 		}
 	}
 
-	podManifestFileName := filepath.Base(podPath)
+	manifestFileName := filepath.Base(podPath)
 
-	podManifestPath := filepath.Join(context.CurrentContext().PodsDir(), podManifestFileName)
+	podManifestPath := filepath.Join(context.CurrentContext().PodsDir(), manifestFileName)
 
 	err = ioutil.WriteFile(podManifestPath, input, 0644)
 	if err != nil {
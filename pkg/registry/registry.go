@@ -5,6 +5,8 @@ import (
 	"strings"
 )
 
+var offline bool
+
 type SpiceRegistry interface {
 	GetPod(podPath string) (string, error)
 }
@@ -18,5 +20,14 @@ func GetRegistry(path string) SpiceRegistry {
 		return &LocalFileRegistry{}
 	}
 
+	if offline {
+		return &LocalCacheRegistry{}
+	}
+
 	return &SpiceRackRegistry{}
 }
+
+// SetOffline resolves remote pods only from the local cache
+func SetOffline(isOffline bool) {
+	offline = isOffline
+}
@@ -1,7 +1,9 @@
 package registry_test
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spiceai/spiceai/pkg/constants"
@@ -14,6 +16,8 @@ import (
 func TestRegistry(t *testing.T) {
 	testutils.EnsureTestSpiceDirectory(t)
 	t.Run("testGetPod() -- Local registry should fetch pod", testGetPod())
+	t.Run("testGetCachedPod() -- Local cache registry should fetch a cached pod", testGetCachedPod())
+	t.Run("testGetUncachedPod() -- Local cache registry should report a missing pod", testGetUncachedPod())
 	t.Cleanup(testutils.CleanupTestSpiceDirectory)
 }
 
@@ -31,3 +35,39 @@ func testGetPod() func(*testing.T) {
 		}
 	}
 }
+
+func testGetCachedPod() func(*testing.T) {
+	return func(t *testing.T) {
+		homeDir := t.TempDir()
+		t.Setenv("HOME", homeDir)
+
+		manifest, err := os.ReadFile("../../test/assets/pods/manifests/trader.yaml")
+		assert.NoError(t, err)
+
+		cacheDir := filepath.Join(homeDir, constants.DotSpice, "registry", "samples", "Trader", "0.1.0")
+		assert.NoError(t, os.MkdirAll(cacheDir, 0766))
+		assert.NoError(t, os.WriteFile(filepath.Join(cacheDir, "trader.yaml"), manifest, 0644))
+
+		r := &registry.LocalCacheRegistry{}
+		_, err = r.GetPod("samples/Trader@0.1.0")
+		assert.NoError(t, err)
+		defer os.RemoveAll(constants.SpicePodsDirectoryName)
+
+		pod, err := pods.LoadPodFromManifest("spicepods/trader.yaml")
+		if assert.NoError(t, err) {
+			assert.Contains(t, pod.Name, "trader")
+		}
+	}
+}
+
+func testGetUncachedPod() func(*testing.T) {
+	return func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		r := &registry.LocalCacheRegistry{}
+		_, err := r.GetPod("samples/Trader")
+
+		var itemNotFound *registry.RegistryItemNotFound
+		assert.True(t, errors.As(err, &itemNotFound))
+	}
+}
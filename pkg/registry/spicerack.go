@@ -6,7 +6,6 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/spiceai/spiceai/pkg/context"
 	spice_http "github.com/spiceai/spiceai/pkg/http"
@@ -14,27 +13,15 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	spiceRackBaseUrl string = "https://api.spicerack.org/api/v0.1"
-)
-
 var (
-	zaplog *zap.Logger = loggers.ZapLogger()
+	spiceRackBaseUrl string      = "https://api.spicerack.org/api/v0.1"
+	zaplog           *zap.Logger = loggers.ZapLogger()
 )
 
 type SpiceRackRegistry struct{}
 
 func (r *SpiceRackRegistry) GetPod(podFullPath string) (string, error) {
-	parts := strings.Split(podFullPath, "@")
-	podPath := podFullPath
-	podVersion := ""
-	if len(parts) == 2 {
-		podPath = parts[0]
-		podVersion = parts[1]
-	}
-
-	podName := strings.ToLower(filepath.Base(podPath))
-	podManifestFileName := fmt.Sprintf("%s.yaml", podName)
+	podPath, podVersion := splitPodVersion(podFullPath)
 
 	url := fmt.Sprintf("%s/pods/%s", spiceRackBaseUrl, podPath)
 	if podVersion != "" {
@@ -45,26 +32,35 @@ func (r *SpiceRackRegistry) GetPod(podFullPath string) (string, error) {
 	response, err := spice_http.Get(url)
 	if err != nil {
 		zaplog.Sugar().Debugf("%s: %s", failureMessage, err.Error())
-		return "", errors.New(failureMessage)
+		return getCachedPod(podFullPath, errors.New(failureMessage))
 	}
 	defer response.Body.Close()
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
 		zaplog.Sugar().Debugf("%s: %s", failureMessage, err.Error())
-		return "", errors.New(failureMessage)
+		return getCachedPod(podFullPath, errors.New(failureMessage))
 	}
 
 	if response.StatusCode == 404 {
+		if podVersion != "" && podExists(podPath) {
+			return "", NewRegistryItemVersionNotFound(fmt.Errorf("version %s of pod %s not found", podVersion, podPath))
+		}
 		return "", NewRegistryItemNotFound(fmt.Errorf("pod %s not found", podPath))
 	}
 
 	if response.StatusCode != 200 {
-		return "", fmt.Errorf("an error occurred fetching pod '%s'", podPath)
+		return getCachedPod(podFullPath, spice_http.NewResponseError(fmt.Sprintf("an error occurred fetching pod '%s'", podPath), response))
+	}
+
+	err = cachePod(podPath, podVersion, body)
+	if err != nil {
+		// The pod can still be added, it just won't be available offline
+		zaplog.Sugar().Debugf("failed to cache pod %s: %s", podFullPath, err.Error())
 	}
 
 	podsPath := context.CurrentContext().PodsDir()
-	downloadPath := filepath.Join(podsPath, podManifestFileName)
+	downloadPath := filepath.Join(podsPath, podManifestFileName(podPath))
 
 	err = os.MkdirAll(podsPath, 0766)
 	if err != nil {
@@ -78,3 +74,28 @@ func (r *SpiceRackRegistry) GetPod(podFullPath string) (string, error) {
 
 	return downloadPath, nil
 }
+
+// podExists checks whether any version of the pod is published, used to tell a
+// missing pod apart from a missing version
+func podExists(podPath string) bool {
+	url := fmt.Sprintf("%s/pods/%s", spiceRackBaseUrl, podPath)
+	response, err := spice_http.Get(url)
+	if err != nil {
+		return false
+	}
+	defer response.Body.Close()
+
+	return response.StatusCode == 200
+}
+
+// getCachedPod falls back to a previously fetched copy of the pod when spicerack.org can't be reached
+func getCachedPod(podFullPath string, remoteErr error) (string, error) {
+	downloadPath, err := (&LocalCacheRegistry{}).GetPod(podFullPath)
+	if err != nil {
+		return "", remoteErr
+	}
+
+	fmt.Printf("spicerack.org is unavailable, using the locally cached copy of %s\n", podFullPath)
+
+	return downloadPath, nil
+}
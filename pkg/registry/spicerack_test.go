@@ -0,0 +1,59 @@
+package registry
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpiceRackRegistry(t *testing.T) {
+	t.Run("GetPod() -- Reports a missing version of an existing pod", testGetPodMissingVersion())
+	t.Run("GetPod() -- Reports a missing pod", testGetPodMissingPod())
+}
+
+func withTestSpiceRack(t *testing.T, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	originalBaseUrl := spiceRackBaseUrl
+	spiceRackBaseUrl = server.URL
+	t.Cleanup(func() {
+		spiceRackBaseUrl = originalBaseUrl
+		server.Close()
+	})
+}
+
+func testGetPodMissingVersion() func(*testing.T) {
+	return func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		withTestSpiceRack(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/pods/samples/trader" {
+				_, _ = w.Write([]byte("name: trader\n"))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		_, err := (&SpiceRackRegistry{}).GetPod("samples/trader@9.9.9")
+
+		var versionNotFound *RegistryItemVersionNotFound
+		assert.True(t, errors.As(err, &versionNotFound))
+	}
+}
+
+func testGetPodMissingPod() func(*testing.T) {
+	return func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		withTestSpiceRack(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		_, err := (&SpiceRackRegistry{}).GetPod("samples/missing@1.0.0")
+
+		var versionNotFound *RegistryItemVersionNotFound
+		var itemNotFound *RegistryItemNotFound
+		assert.False(t, errors.As(err, &versionNotFound))
+		assert.True(t, errors.As(err, &itemNotFound))
+	}
+}
@@ -1,17 +1,18 @@
 package runtime
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/viper"
 	"github.com/spiceai/spiceai/pkg/aiengine"
 	"github.com/spiceai/spiceai/pkg/config"
-	"github.com/spiceai/spiceai/pkg/context"
+	spice_context "github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/environment"
 	spice_http "github.com/spiceai/spiceai/pkg/http"
 	"github.com/spiceai/spiceai/pkg/loggers"
@@ -21,6 +22,12 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	defaultPodScanConcurrency    uint = 4
+	defaultPodsWatcherDebounceMs uint = 500
+	httpShutdownTimeout               = 15 * time.Second
+)
+
 type SpiceRuntime struct {
 	config *config.SpiceConfiguration
 	viper  *viper.Viper
@@ -38,7 +45,7 @@ func (r *SpiceRuntime) LoadConfig() error {
 
 	var err error
 	if r.config == nil {
-		appDir := context.CurrentContext().AppDir()
+		appDir := spice_context.CurrentContext().AppDir()
 		r.config, err = config.LoadRuntimeConfiguration(r.viper, appDir)
 	}
 
@@ -46,6 +53,14 @@ func (r *SpiceRuntime) LoadConfig() error {
 }
 
 func (r *SpiceRuntime) printStartupBanner(mode string) {
+	if loggers.IsJsonLogFormat() {
+		zaplog.Info("Spice runtime started",
+			zap.String("version", version.Version()),
+			zap.String("mode", mode),
+			zap.Uint("http_port", runtime.config.HttpPort))
+		return
+	}
+
 	fmt.Printf("- Runtime version: %s\n", version.Version())
 	if mode != "" {
 		fmt.Printf("- Mode: %s\n", mode)
@@ -55,7 +70,7 @@ func (r *SpiceRuntime) printStartupBanner(mode string) {
 	fmt.Println("Use Ctrl-C to stop")
 }
 
-func SingleRun(manifestPath string) error {
+func SingleRun(ctx context.Context, manifestPath string) error {
 	err := startRuntime()
 	if err != nil {
 		return err
@@ -67,7 +82,7 @@ func SingleRun(manifestPath string) error {
 		return err
 	}
 
-	err = spice_http.NewServer(runtime.config.HttpPort).Start()
+	err = spice_http.NewServer(runtime.config.HttpPort).Start(ctx)
 	if err != nil {
 		return err
 	}
@@ -81,7 +96,7 @@ func SingleRun(manifestPath string) error {
 		return err
 	}
 
-	err = environment.StartDataListeners(15)
+	err = environment.StartDataListeners(ctx, 15)
 	if err != nil {
 		return err
 	}
@@ -91,12 +106,18 @@ func SingleRun(manifestPath string) error {
 		return err
 	}
 
-	fmt.Println(aurora.Green("Exiting after single training run."))
+	if loggers.IsJsonLogFormat() {
+		zaplog.Info("Exiting after single training run")
+	} else {
+		fmt.Println(aurora.Green("Exiting after single training run."))
+	}
 
 	return nil
 }
 
-func Run() error {
+// Run starts the runtime and blocks until ctx is cancelled, at which point the
+// HTTP server, pods watcher and data listeners are stopped before returning
+func Run(ctx context.Context) error {
 	err := startRuntime()
 	if err != nil {
 		return err
@@ -108,7 +129,8 @@ func Run() error {
 		return err
 	}
 
-	err = spice_http.NewServer(runtime.config.HttpPort).Start()
+	httpServer := spice_http.NewServer(runtime.config.HttpPort)
+	err = httpServer.Start(ctx)
 	if err != nil {
 		return err
 	}
@@ -117,49 +139,86 @@ func Run() error {
 
 	runtime.printStartupBanner("")
 
-	err = runtime.scanForPods()
+	podErrors, err := runtime.scanForPods()
 	if err != nil {
-		log.Printf("error scanning for pods: %s", err.Error())
+		zaplog.Sugar().Errorf("error scanning for pods: %s", err.Error())
 		return err
 	}
+	if len(podErrors) > 0 {
+		zaplog.Sugar().Warnf("skipped %d pod manifest(s) in %s that failed to load", len(podErrors), spice_context.CurrentContext().PodsDir())
+	}
 
-	err = watchPods()
+	err = watchPods(ctx, runtime.podsWatcherDebounce())
 	if err != nil {
 		zaplog.Sugar().Errorf("error watching for pods: %s", err.Error())
 		return err
 	}
 
-	err = environment.StartDataListeners(15)
+	err = environment.StartDataListeners(ctx, 15)
 	if err != nil {
 		return err
 	}
 
+	<-ctx.Done()
+
+	// Let any in-flight work finish so Shutdown() doesn't pull temp directories out from under it
+	select {
+	case <-httpServer.Done():
+	case <-time.After(httpShutdownTimeout):
+		zaplog.Sugar().Warnf("HTTP server did not shut down within %s, continuing", httpShutdownTimeout)
+	}
+	watchersWg.Wait()
+	environment.WaitForDataListeners()
+
 	return nil
 }
 
-func (r *SpiceRuntime) scanForPods() error {
-	_, err := os.Stat(context.CurrentContext().AppDir())
+// scanForPods loads every manifest in the pods directory. A manifest that fails to load is skipped so the
+// remaining pods still load, and its error is returned keyed by manifest path.
+func (r *SpiceRuntime) scanForPods() (map[string]error, error) {
+	_, err := os.Stat(spice_context.CurrentContext().AppDir())
 	if err != nil {
 		// No .spice means no pods
-		return nil
+		return nil, nil
 	}
 
-	podsManifestDir := context.CurrentContext().PodsDir()
+	podsManifestDir := spice_context.CurrentContext().PodsDir()
 	_, err = os.Stat(podsManifestDir)
 	if err != nil {
 		// No spicepods means no pods
-		return nil
+		return nil, nil
 	}
 
 	d, err := os.Open(podsManifestDir)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	files, err := d.Readdir(-1)
 	d.Close()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	podErrors := make(map[string]error)
+	var podErrorsMutex sync.Mutex
+
+	manifestPaths := make(chan string)
+	wg := new(sync.WaitGroup)
+	for i := uint(0); i < r.podScanConcurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for manifestPath := range manifestPaths {
+				// initializePod has already logged the error
+				_, err := initializePod(manifestPath)
+				if err != nil {
+					podErrorsMutex.Lock()
+					podErrors[manifestPath] = err
+					podErrorsMutex.Unlock()
+				}
+			}
+		}()
 	}
 
 	for _, f := range files {
@@ -167,15 +226,28 @@ func (r *SpiceRuntime) scanForPods() error {
 			continue
 		}
 
-		manifestPath := filepath.Join(podsManifestDir, f.Name())
-		_, err = initializePod(manifestPath)
-		if err != nil {
-			log.Println(fmt.Errorf("error loading pod manifest %s: %w", manifestPath, err))
-			continue
-		}
+		manifestPaths <- filepath.Join(podsManifestDir, f.Name())
 	}
+	close(manifestPaths)
 
-	return nil
+	wg.Wait()
+
+	return podErrors, nil
+}
+
+func (r *SpiceRuntime) podScanConcurrency() uint {
+	if r.config.PodScanConcurrency == 0 {
+		return defaultPodScanConcurrency
+	}
+	return r.config.PodScanConcurrency
+}
+
+func (r *SpiceRuntime) podsWatcherDebounce() time.Duration {
+	debounceMs := r.config.PodsWatcherDebounceMs
+	if debounceMs == 0 {
+		debounceMs = defaultPodsWatcherDebounceMs
+	}
+	return time.Duration(debounceMs) * time.Millisecond
 }
 
 func startRuntime() error {
@@ -186,7 +258,11 @@ func startRuntime() error {
 		return err
 	}
 
-	fmt.Println("Loading Spice runtime ...")
+	if loggers.IsJsonLogFormat() {
+		zaplog.Info("Loading Spice runtime")
+	} else {
+		fmt.Println("Loading Spice runtime ...")
+	}
 
 	return nil
 }
@@ -194,26 +270,30 @@ func startRuntime() error {
 func initializePod(manifestPath string) (*pods.Pod, error) {
 	newPod, err := pods.LoadPodFromManifest(manifestPath)
 	if err != nil {
-		log.Println(fmt.Errorf("error loading pod manifest %s: %w", manifestPath, err))
+		zaplog.Sugar().Errorf("error loading pod manifest %s: %s", manifestPath, err.Error())
 		return nil, err
 	}
 
 	pods.CreateOrUpdatePod(newPod)
 	err = aiengine.InitializePod(newPod)
 	if err != nil {
-		log.Println(fmt.Errorf("error initializing pod %s: %w", newPod.Name, err))
+		zaplog.Sugar().Errorf("error initializing pod %s: %s", newPod.Name, err.Error())
 		return nil, err
 	}
 
 	for _, ds := range newPod.DataSources() {
-		fmt.Printf("Loaded dataspace %s\n", aurora.BrightCyan(ds.Name()))
+		if loggers.IsJsonLogFormat() {
+			zaplog.Info("Loaded dataspace", zap.String("pod", newPod.Name), zap.String("dataspace", ds.Name()))
+		} else {
+			fmt.Printf("Loaded dataspace %s\n", aurora.BrightCyan(ds.Name()))
+		}
 	}
 
 	return newPod, nil
 }
 
 func Shutdown() {
-	log.Println("Shutting down...")
+	zaplog.Info("Shutting down...")
 
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
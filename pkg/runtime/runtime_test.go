@@ -0,0 +1,140 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spiceai/spiceai/pkg/aiengine"
+	"github.com/spiceai/spiceai/pkg/config"
+	spice_context "github.com/spiceai/spiceai/pkg/context"
+	"github.com/spiceai/spiceai/pkg/pods"
+	"github.com/spiceai/spiceai/pkg/proto/aiengine_pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestScanForPods(t *testing.T) {
+	t.Run("scanForPods() -- Loads the good manifests and reports the bad one", testScanForPodsWithBadManifest())
+}
+
+func testScanForPodsWithBadManifest() func(*testing.T) {
+	return func(t *testing.T) {
+		assetsDir, err := filepath.Abs("../../test/assets/pods/manifests")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		podsDir := useTestAppDir(t)
+
+		manifestPaths := make([]string, 0)
+		for _, name := range []string{"trader.yaml", "logpruner.yaml"} {
+			manifestPaths = append(manifestPaths, copyTestManifest(t, filepath.Join(assetsDir, name), podsDir))
+		}
+
+		badManifestPath := filepath.Join(podsDir, "bad.yaml")
+		err = os.WriteFile(badManifestPath, []byte("dataspaces: [\n"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		aiengine.SetAIEngineClient(&aiengine.MockAIEngineClient{
+			InitHandler: func(c context.Context, ir *aiengine_pb.InitRequest, co ...grpc.CallOption) (*aiengine_pb.Response, error) {
+				return &aiengine_pb.Response{Result: "ok"}, nil
+			},
+		})
+		t.Cleanup(func() {
+			aiengine.SetAIEngineClient(nil)
+		})
+
+		t.Cleanup(func() {
+			for _, pod := range pods.Pods() {
+				pods.RemovePod(pod.Name)
+			}
+		})
+
+		// Read the pods while they load so -race sees the snapshot taken alongside the writers
+		done := make(chan struct{})
+		readerDone := make(chan struct{})
+		go func() {
+			defer close(readerDone)
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					for _, pod := range pods.Pods() {
+						_ = pod.ManifestPath()
+					}
+				}
+			}
+		}()
+
+		r := &SpiceRuntime{config: &config.SpiceConfiguration{PodScanConcurrency: 3}}
+		podErrors, err := r.scanForPods()
+		close(done)
+		<-readerDone
+
+		assert.NoError(t, err)
+		if assert.Len(t, podErrors, 1) {
+			assert.Error(t, podErrors[badManifestPath])
+		}
+
+		loadedManifestPaths := make([]string, 0)
+		for _, pod := range pods.Pods() {
+			loadedManifestPaths = append(loadedManifestPaths, pod.ManifestPath())
+		}
+		assert.ElementsMatch(t, manifestPaths, loadedManifestPaths)
+	}
+}
+
+// useTestAppDir points the current context at a temporary app directory and returns its pods directory
+func useTestAppDir(t *testing.T) string {
+	origContext := spice_context.CurrentContext()
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(origDir)
+		spice_context.SetContext(origContext)
+	})
+
+	err = os.Chdir(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rtcontext, err := spice_context.NewContext("metal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rtcontext.Init()
+	if err != nil {
+		t.Fatal(err)
+	}
+	spice_context.SetContext(rtcontext)
+
+	err = os.MkdirAll(rtcontext.PodsDir(), 0766)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rtcontext.PodsDir()
+}
+
+func copyTestManifest(t *testing.T, sourcePath string, podsDir string) string {
+	manifest, err := os.ReadFile(sourcePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifestPath := filepath.Join(podsDir, filepath.Base(sourcePath))
+	err = os.WriteFile(manifestPath, manifest, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return manifestPath
+}
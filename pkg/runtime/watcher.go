@@ -1,19 +1,26 @@
 package runtime
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/spiceai/spiceai/pkg/aiengine"
-	"github.com/spiceai/spiceai/pkg/context"
+	spice_context "github.com/spiceai/spiceai/pkg/context"
 	"github.com/spiceai/spiceai/pkg/pods"
 )
 
+var (
+	watchersWg sync.WaitGroup
+)
+
 func ensurePodsPathExists() error {
-	podsDir := context.CurrentContext().PodsDir()
+	podsDir := spice_context.CurrentContext().PodsDir()
 	if _, err := os.Stat(podsDir); os.IsNotExist(err) {
 		err := os.MkdirAll(podsDir, os.ModePerm)
 		if err != nil {
@@ -23,14 +30,20 @@ func ensurePodsPathExists() error {
 	return nil
 }
 
-func watchPods() error {
-	podsDir := context.CurrentContext().PodsDir()
+// watchPods reloads pods as their manifests change until ctx is cancelled.
+// Events are coalesced until no more arrive for the debounce duration, so a burst of
+// saves or copies results in a single reload per manifest.
+func watchPods(ctx context.Context, debounce time.Duration) error {
+	podsDir := spice_context.CurrentContext().PodsDir()
 	if err := ensurePodsPathExists(); err != nil {
 		// Ignore this error, just don't watch
 		return nil
 	}
 
+	watchersWg.Add(1)
 	go func() {
+		defer watchersWg.Done()
+
 		watcher, err := fsnotify.NewWatcher()
 		if err != nil {
 			log.Println(fmt.Errorf("error starting '%s' watcher: %w", podsDir, err))
@@ -40,22 +53,67 @@ func watchPods() error {
 		if err := watcher.Add(podsDir); err != nil {
 			log.Println(fmt.Errorf("error starting '%s' watcher: %w", podsDir, err))
 		}
-		for {
-			select {
-			case event := <-watcher.Events:
-				err := processNotifyEvent(event)
-				if err != nil {
-					log.Println(err)
+
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case err := <-watcher.Errors:
+					log.Println(fmt.Errorf("error from '%s' watcher: %w", podsDir, err))
 				}
-			case err := <-watcher.Errors:
-				log.Println(fmt.Errorf("error from '%s' watcher: %w", podsDir, err))
 			}
-		}
+		}()
+
+		debounceEvents(ctx, watcher.Events, debounce, func(event fsnotify.Event) {
+			err := processNotifyEvent(event)
+			if err != nil {
+				log.Println(err)
+			}
+		})
 	}()
 
 	return nil
 }
 
+// debounceEvents merges the events for each file until none arrive for the debounce duration,
+// then calls process once per file with the combined operations
+func debounceEvents(ctx context.Context, events <-chan fsnotify.Event, debounce time.Duration, process func(fsnotify.Event)) {
+	pendingEvents := make(map[string]fsnotify.Event)
+	var flush <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			if event.Op == fsnotify.Chmod {
+				// cp -p, touch and many editors follow a change with a Chmod, which isn't a change to the manifest
+				continue
+			}
+			pendingEvents[event.Name] = mergeEvents(pendingEvents[event.Name], event)
+			flush = time.After(debounce)
+		case <-flush:
+			for _, event := range pendingEvents {
+				process(event)
+			}
+			pendingEvents = make(map[string]fsnotify.Event)
+			flush = nil
+		}
+	}
+}
+
+// A removal supersedes earlier changes to the file, and a change after a removal means it was recreated
+func mergeEvents(pending fsnotify.Event, event fsnotify.Event) fsnotify.Event {
+	if event.Op&fsnotify.Remove != 0 {
+		event.Op = fsnotify.Remove
+		return event
+	}
+
+	event.Op |= pending.Op &^ fsnotify.Remove
+	return event
+}
+
 func processNotifyEvent(event fsnotify.Event) error {
 	manifestPath := event.Name
 	ext := filepath.Ext(manifestPath)
@@ -64,17 +122,20 @@ func processNotifyEvent(event fsnotify.Event) error {
 		return nil
 	}
 
-	switch event.Op {
-	case fsnotify.Create:
+	if event.Op&fsnotify.Remove != 0 {
+		pods.RemovePodByManifestPath(manifestPath)
+		return nil
+	}
+
+	if event.Op&fsnotify.Create != 0 {
 		pod, err := pods.LoadPodFromManifest(manifestPath)
 		if err != nil {
 			return err
 		}
-		err = startNewPodTraining(pod)
-		if err != nil {
-			return err
-		}
-	case fsnotify.Write:
+		return startNewPodTraining(pod)
+	}
+
+	if event.Op&fsnotify.Write != 0 {
 		newPod, err := pods.LoadPodFromManifest(manifestPath)
 		if err != nil {
 			return err
@@ -82,16 +143,10 @@ func processNotifyEvent(event fsnotify.Event) error {
 		existingPod := pods.GetPod(newPod.Name)
 		if existingPod != nil && newPod.Hash() == existingPod.Hash() {
 			// Nothing changed, ignore
-			break
+			return nil
 		}
 		// TODO: Check if datasources have actually changed
-		err = startNewPodTraining(newPod)
-		if err != nil {
-			return err
-		}
-	case fsnotify.Remove:
-		pods.RemovePodByManifestPath(manifestPath)
-		return nil
+		return startNewPodTraining(newPod)
 	}
 
 	return nil
@@ -0,0 +1,86 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher(t *testing.T) {
+	t.Run("debounceEvents() -- A Create, Write, Chmod burst is a single reload", testDebounceCreateWriteChmod())
+	t.Run("debounceEvents() -- A removal after a change is a removal", testDebounceWriteRemove())
+	t.Run("debounceEvents() -- A Chmod on its own is ignored", testDebounceChmodOnly())
+}
+
+// runDebounce feeds events through debounceEvents and returns what was processed once things settle
+func runDebounce(t *testing.T, events ...fsnotify.Event) []fsnotify.Event {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventsChan := make(chan fsnotify.Event)
+	var mu sync.Mutex
+	processed := make([]fsnotify.Event, 0)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		debounceEvents(ctx, eventsChan, 50*time.Millisecond, func(event fsnotify.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			processed = append(processed, event)
+		})
+	}()
+
+	for _, event := range events {
+		eventsChan <- event
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	return processed
+}
+
+func testDebounceCreateWriteChmod() func(*testing.T) {
+	return func(t *testing.T) {
+		manifestPath := "spicepods/trader.yaml"
+		processed := runDebounce(t,
+			fsnotify.Event{Name: manifestPath, Op: fsnotify.Create},
+			fsnotify.Event{Name: manifestPath, Op: fsnotify.Write},
+			fsnotify.Event{Name: manifestPath, Op: fsnotify.Chmod},
+		)
+
+		if assert.Len(t, processed, 1) {
+			assert.Equal(t, manifestPath, processed[0].Name)
+			assert.NotZero(t, processed[0].Op&fsnotify.Create)
+			assert.NotZero(t, processed[0].Op&fsnotify.Write)
+		}
+	}
+}
+
+func testDebounceWriteRemove() func(*testing.T) {
+	return func(t *testing.T) {
+		processed := runDebounce(t,
+			fsnotify.Event{Name: "spicepods/trader.yaml", Op: fsnotify.Write},
+			fsnotify.Event{Name: "spicepods/trader.yaml", Op: fsnotify.Remove},
+		)
+
+		if assert.Len(t, processed, 1) {
+			assert.Equal(t, fsnotify.Remove, processed[0].Op)
+		}
+	}
+}
+
+func testDebounceChmodOnly() func(*testing.T) {
+	return func(t *testing.T) {
+		processed := runDebounce(t, fsnotify.Event{Name: "spicepods/trader.yaml", Op: fsnotify.Chmod})
+		assert.Empty(t, processed)
+	}
+}
@@ -40,6 +40,22 @@ func ProcessAFileInZipArchive(zipArchive string, filename string, processFunc Pr
 	return nil
 }
 
+// Returns the names of all files and directories in the zip archive
+func GetZipArchiveFileNames(zipArchive string) ([]string, error) {
+	r, err := zip.OpenReader(zipArchive)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	names := make([]string, 0, len(r.File))
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+
+	return names, nil
+}
+
 func ExtractZipFileToDir(zipArchive string, targetDirectory string) error {
 	r, err := zip.OpenReader(zipArchive)
 	if err != nil {
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("GetZipArchiveFileNames()", testGetZipArchiveFileNamesFunc())
+}
+
+// Tests "GetZipArchiveFileNames()"
+func testGetZipArchiveFileNamesFunc() func(*testing.T) {
+	return func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "trader.spicepod")
+		archive, err := os.Create(archivePath)
+		assert.NoError(t, err)
+
+		zipWriter := zip.NewWriter(archive)
+		_, err = zipWriter.Create("trader.model/")
+		assert.NoError(t, err)
+		_, err = zipWriter.Create("trader.yaml")
+		assert.NoError(t, err)
+		assert.NoError(t, zipWriter.Close())
+		assert.NoError(t, archive.Close())
+
+		fileNames, err := GetZipArchiveFileNames(archivePath)
+		assert.NoError(t, err, "GetZipArchiveFileNames() failed")
+		assert.Equal(t, []string{"trader.model/", "trader.yaml"}, fileNames, "GetZipArchiveFileNames() was incorrect")
+
+		_, err = GetZipArchiveFileNames(filepath.Join(t.TempDir(), "missing.spicepod"))
+		assert.Error(t, err, "GetZipArchiveFileNames() did not return err")
+	}
+}